@@ -1,6 +1,9 @@
 package nbagame
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/jbowens/nbagame/data"
@@ -24,14 +27,16 @@ var (
 	}
 )
 
+// Client retrieves data from the stats.nba.com endpoints. Every method takes
+// a context.Context that governs the underlying HTTP requests.
 type Client struct {
 	requester *endpoints.Requester
 }
 
 // Teams returns a slice of all the current NBA teams.
-func (c *Client) Teams() ([]*data.Team, error) {
+func (c *Client) Teams(ctx context.Context) ([]*data.Team, error) {
 	var resp endpoints.FranchiseHistoryResponse
-	err := c.requester.Request("franchisehistory", &endpoints.FranchiseHistoryParams{
+	err := c.requester.Request(ctx, "franchisehistory", &endpoints.FranchiseHistoryParams{
 		LeagueID: "00",
 	}, &resp)
 	return resp.Present(), err
@@ -39,28 +44,42 @@ func (c *Client) Teams() ([]*data.Team, error) {
 
 // Players retrieves a slice of all players in the NBA in the provided
 // season.
-func (c *Client) Players(season data.Season) ([]*data.Player, error) {
+func (c *Client) Players(ctx context.Context, season data.Season) ([]*data.Player, error) {
 	params := endpoints.CommonAllPlayersParams{
 		LeagueID:            "00",
 		Season:              season.String(),
 		IsOnlyCurrentSeason: 1,
 	}
 	var resp endpoints.CommonAllPlayersResponse
-	if err := c.requester.Request("commonallplayers", &params, &resp); err != nil {
+	if err := c.requester.Request(ctx, "commonallplayers", &params, &resp); err != nil {
 		return nil, err
 	}
 	return resp.Present(), nil
 }
 
+// TeamRoster returns the players and coaching staff on the given team during
+// the provided season.
+func (c *Client) TeamRoster(ctx context.Context, season data.Season, teamID int) (*data.Roster, error) {
+	var resp endpoints.CommonTeamRosterResponse
+	if err := c.requester.Request(ctx, "commonteamroster", &endpoints.CommonTeamRosterParams{
+		LeagueID: "00",
+		Season:   season.String(),
+		TeamID:   teamID,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(season, teamID)
+}
+
 // HistoricalPlayers returns a slice of all players from all time.
-func (c *Client) HistoricalPlayers() ([]*data.Player, error) {
+func (c *Client) HistoricalPlayers(ctx context.Context) ([]*data.Player, error) {
 	params := endpoints.CommonAllPlayersParams{
 		LeagueID:            "00",
 		Season:              "2014-15", // arbitrary
 		IsOnlyCurrentSeason: 0,
 	}
 	var resp endpoints.CommonAllPlayersResponse
-	if err := c.requester.Request("commonallplayers", &params, &resp); err != nil {
+	if err := c.requester.Request(ctx, "commonallplayers", &params, &resp); err != nil {
 		return nil, err
 	}
 	return resp.Present(), nil
@@ -68,9 +87,9 @@ func (c *Client) HistoricalPlayers() ([]*data.Player, error) {
 
 // Details returns detailed information about a player. It does not include
 // stats about the player's performance.
-func (c *Client) PlayerDetails(playerID int) (*data.PlayerDetails, error) {
+func (c *Client) PlayerDetails(ctx context.Context, playerID int) (*data.PlayerDetails, error) {
 	var resp endpoints.CommonPlayerInfoResponse
-	if err := c.requester.Request("commonplayerinfo", &endpoints.CommonPlayerInfoParams{
+	if err := c.requester.Request(ctx, "commonplayerinfo", &endpoints.CommonPlayerInfoParams{
 		LeagueID: "00",
 		PlayerID: playerID,
 	}, &resp); err != nil {
@@ -85,10 +104,10 @@ func (c *Client) PlayerDetails(playerID int) (*data.PlayerDetails, error) {
 
 // Games returns the game IDs of all of the games played in the season,
 // including playoff games.
-func (c *Client) Games(season data.Season) ([]data.GameID, error) {
+func (c *Client) Games(ctx context.Context, season data.Season) ([]data.GameID, error) {
 	var gameIDs []data.GameID
 	for _, teamID := range teamIDs {
-		teamGameIDs, err := c.GamesPlayedBy(season, teamID)
+		teamGameIDs, err := c.GamesPlayedBy(ctx, season, teamID)
 		if err != nil {
 			return nil, err
 		}
@@ -99,9 +118,9 @@ func (c *Client) Games(season data.Season) ([]data.GameID, error) {
 }
 
 // GameDetails returns detailed information about the given game.
-func (c *Client) GameDetails(gameID string) (*data.GameDetails, error) {
+func (c *Client) GameDetails(ctx context.Context, gameID string) (*data.GameDetails, error) {
 	var resp endpoints.BoxScoreSummaryResponse
-	if err := c.requester.Request("boxscoresummaryv2", &endpoints.BoxScoreSummaryParams{
+	if err := c.requester.Request(ctx, "boxscoresummaryv2", &endpoints.BoxScoreSummaryParams{
 		GameID: gameID,
 	}, &resp); err != nil {
 		return nil, err
@@ -110,14 +129,111 @@ func (c *Client) GameDetails(gameID string) (*data.GameDetails, error) {
 }
 
 // BoxScore returns the box score for the given game.
-func (c *Client) BoxScore(season data.Season, gameID string) (*data.BoxScore, error) {
+func (c *Client) BoxScore(ctx context.Context, season data.Season, gameID string) (*data.BoxScore, error) {
+	var resp endpoints.BoxScoreTraditionalResponse
+	params := boxScoreParams(season, gameID)
+	if err := c.requester.Request(ctx, "boxscoretraditionalv2", &params, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.TeamStats) == 0 {
+		return nil, nil
+	}
+
+	teamStats, playerStats := resp.ToData()
+	return &data.BoxScore{TeamStats: teamStats, PlayerStats: playerStats}, nil
+}
+
+// AdvancedBoxScore returns the advanced box score for the given game, with
+// each team and player's ratings, percentages, pace and PIE.
+func (c *Client) AdvancedBoxScore(ctx context.Context, season data.Season, gameID string) (*data.AdvancedBoxScore, error) {
+	var resp endpoints.BoxScoreAdvancedResponse
+	params := endpoints.BoxScoreAdvancedParams(boxScoreParams(season, gameID))
+	if err := c.requester.Request(ctx, "boxscoreadvancedv2", &params, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.TeamStats) == 0 {
+		return nil, nil
+	}
+	return resp.ToData(), nil
+}
+
+// FourFactorsBoxScore returns the four factors box score for the given game.
+// Only the FourFactors stats of the returned box score are set.
+func (c *Client) FourFactorsBoxScore(ctx context.Context, season data.Season, gameID string) (*data.FullBoxScore, error) {
+	params := endpoints.BoxScoreFourFactorsParams(boxScoreParams(season, gameID))
+	return c.boxScoreVariants(ctx, boxScoreVariant{"boxscorefourfactorsv2", &params, &endpoints.BoxScoreFourFactorsResponse{}})
+}
+
+// MiscBoxScore returns the misc box score for the given game. Only the Misc
+// stats of the returned box score are set.
+func (c *Client) MiscBoxScore(ctx context.Context, season data.Season, gameID string) (*data.FullBoxScore, error) {
+	params := endpoints.BoxScoreMiscParams(boxScoreParams(season, gameID))
+	return c.boxScoreVariants(ctx, boxScoreVariant{"boxscoremiscv2", &params, &endpoints.BoxScoreMiscResponse{}})
+}
+
+// ScoringBoxScore returns the scoring box score for the given game. Only the
+// Scoring stats of the returned box score are set.
+func (c *Client) ScoringBoxScore(ctx context.Context, season data.Season, gameID string) (*data.FullBoxScore, error) {
+	params := endpoints.BoxScoreScoringParams(boxScoreParams(season, gameID))
+	return c.boxScoreVariants(ctx, boxScoreVariant{"boxscorescoringv2", &params, &endpoints.BoxScoreScoringResponse{}})
+}
+
+// UsageBoxScore returns the usage box score for the given game. Only the
+// Usage stats of the returned box score are set.
+func (c *Client) UsageBoxScore(ctx context.Context, season data.Season, gameID string) (*data.FullBoxScore, error) {
+	params := endpoints.BoxScoreUsageParams(boxScoreParams(season, gameID))
+	return c.boxScoreVariants(ctx, boxScoreVariant{"boxscoreusagev2", &params, &endpoints.BoxScoreUsageResponse{}})
+}
+
+// FullBoxScore returns every variant of the box score for the given game,
+// merged into one stat line per team and player.
+func (c *Client) FullBoxScore(ctx context.Context, season data.Season, gameID string) (*data.FullBoxScore, error) {
+	traditional := boxScoreParams(season, gameID)
+	advanced := endpoints.BoxScoreAdvancedParams(traditional)
+	fourFactors := endpoints.BoxScoreFourFactorsParams(traditional)
+	misc := endpoints.BoxScoreMiscParams(traditional)
+	scoring := endpoints.BoxScoreScoringParams(traditional)
+	usage := endpoints.BoxScoreUsageParams(traditional)
+	return c.boxScoreVariants(ctx,
+		boxScoreVariant{"boxscoretraditionalv2", &traditional, &endpoints.BoxScoreTraditionalResponse{}},
+		boxScoreVariant{"boxscoreadvancedv2", &advanced, &endpoints.BoxScoreAdvancedResponse{}},
+		boxScoreVariant{"boxscorefourfactorsv2", &fourFactors, &endpoints.BoxScoreFourFactorsResponse{}},
+		boxScoreVariant{"boxscoremiscv2", &misc, &endpoints.BoxScoreMiscResponse{}},
+		boxScoreVariant{"boxscorescoringv2", &scoring, &endpoints.BoxScoreScoringResponse{}},
+		boxScoreVariant{"boxscoreusagev2", &usage, &endpoints.BoxScoreUsageResponse{}},
+	)
+}
+
+// boxScoreVariant is a request for one variant of a game's box score.
+type boxScoreVariant struct {
+	endpoint string
+	params   interface{}
+	resp     endpoints.BoxScoreMerger
+}
+
+// boxScoreVariants requests each box score variant, and merges them into
+// one box score. If the game has no box score yet, nil is returned.
+func (c *Client) boxScoreVariants(ctx context.Context, variants ...boxScoreVariant) (*data.FullBoxScore, error) {
+	box := &data.FullBoxScore{}
+	for _, variant := range variants {
+		if err := c.requester.Request(ctx, variant.endpoint, variant.params, variant.resp); err != nil {
+			return nil, err
+		}
+		variant.resp.MergeInto(box)
+	}
+	if len(box.TeamStats) == 0 {
+		return nil, nil
+	}
+	return box, nil
+}
+
+// boxScoreParams returns the parameters of a full game box score request.
+func boxScoreParams(season data.Season, gameID string) endpoints.BoxScoreTraditionalParams {
 	seasonType := "Regular Season"
 	if data.GameID(gameID).IsPlayoff() {
 		seasonType = "Playoffs"
 	}
-
-	var resp endpoints.BoxScoreTraditionalResponse
-	if err := c.requester.Request("boxscoretraditionalv2", &endpoints.BoxScoreTraditionalParams{
+	return endpoints.BoxScoreTraditionalParams{
 		GameID:      gameID,
 		Season:      season.String(),
 		SeasonType:  seasonType,
@@ -126,26 +242,18 @@ func (c *Client) BoxScore(season data.Season, gameID string) (*data.BoxScore, er
 		StartRange:  0,
 		EndRange:    28800,
 		RangeType:   2,
-	}, &resp); err != nil {
-		return nil, err
 	}
-	if len(resp.TeamStats) == 0 {
-		return nil, nil
-	}
-
-	teamStats, playerStats := resp.ToData()
-	return &data.BoxScore{teamStats, playerStats}, nil
 }
 
 // GamePlayByPlay returns a play-by-play list of events for a game.
-func (c *Client) GamePlayByPlay(season data.Season, gameID string) ([]*data.Event, error) {
+func (c *Client) GamePlayByPlay(ctx context.Context, season data.Season, gameID string) ([]*data.Event, error) {
 	seasonType := "Regular Season"
 	if data.GameID(gameID).IsPlayoff() {
 		seasonType = "Playoffs"
 	}
 
 	var resp endpoints.PlayByPlayResponse
-	if err := c.requester.Request("playbyplayv2", &endpoints.PlayByPlayParams{
+	if err := c.requester.Request(ctx, "playbyplayv2", &endpoints.PlayByPlayParams{
 		GameID:      gameID,
 		Season:      season.String(),
 		SeasonType:  seasonType,
@@ -160,29 +268,204 @@ func (c *Client) GamePlayByPlay(season data.Season, gameID string) ([]*data.Even
 	return resp.ToData(), nil
 }
 
+// PlayerGameLog returns the given player's stat line from each game they
+// played in the provided season, most recent first, narrowed down by filter.
+func (c *Client) PlayerGameLog(ctx context.Context, playerID int, season data.Season, filter GameLogFilter) ([]*data.PlayerGameLogEntry, error) {
+	var resp endpoints.PlayerGameLogResponse
+	if err := c.requester.Request(ctx, "playergamelog", &endpoints.PlayerGameLogParams{
+		LeagueID:   "00",
+		PlayerID:   playerID,
+		Season:     season.String(),
+		SeasonType: filter.seasonType(),
+		DateFrom:   filter.DateFrom,
+		DateTo:     filter.DateTo,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(), nil
+}
+
+// PlayerCareer returns the given player's stats for each season of their
+// career, and their career totals, in the NBA and in college. The stats are
+// totals or averages according to perMode.
+func (c *Client) PlayerCareer(ctx context.Context, playerID int, perMode data.PerMode) (*data.PlayerCareer, error) {
+	var resp endpoints.PlayerCareerStatsResponse
+	if err := c.requester.Request(ctx, "playercareerstats", &endpoints.PlayerCareerStatsParams{
+		LeagueID: "00",
+		PerMode:  perMode.String(),
+		PlayerID: playerID,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(playerID, perMode), nil
+}
+
+// ShotChart returns the shots included by filter, and the league's averages
+// from each zone of the court.
+func (c *Client) ShotChart(ctx context.Context, filter ShotChartFilter) (*data.ShotChart, error) {
+	var resp endpoints.ShotChartDetailResponse
+	if err := c.requester.Request(ctx, "shotchartdetail", &endpoints.ShotChartDetailParams{
+		ContextMeasure: "FGA",
+		LeagueID:       "00",
+		PlayerID:       filter.PlayerID,
+		TeamID:         filter.TeamID,
+		GameID:         string(filter.GameID),
+		OpponentTeamID: filter.OpponentTeamID,
+		Period:         filter.Period,
+		Season:         filter.season().String(),
+		SeasonType:     filter.seasonType(),
+		StartPeriod:    1,
+		EndPeriod:      10,
+		EndRange:       28800,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	sort.Sort(&resp)
+	return resp.ToData(), nil
+}
+
+// LeaguePlayerStats returns the stats of every player in the league,
+// aggregated over the games included by filter.
+func (c *Client) LeaguePlayerStats(ctx context.Context, filter LeagueStatsFilter) ([]*data.LeaguePlayerStats, error) {
+	params, err := filter.params()
+	if err != nil {
+		return nil, err
+	}
+	if filter.measureType() == data.MeasureAdvanced {
+		var resp endpoints.LeagueDashPlayerAdvancedResponse
+		if err := c.requester.Request(ctx, "leaguedashplayerstats", &params, &resp); err != nil {
+			return nil, err
+		}
+		return resp.ToData(), nil
+	}
+	var resp endpoints.LeagueDashPlayerStatsResponse
+	if err := c.requester.Request(ctx, "leaguedashplayerstats", &params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(), nil
+}
+
+// LeagueTeamStats returns the stats of every team in the league, aggregated
+// over the games included by filter.
+func (c *Client) LeagueTeamStats(ctx context.Context, filter LeagueStatsFilter) ([]*data.LeagueTeamStats, error) {
+	playerParams, err := filter.params()
+	if err != nil {
+		return nil, err
+	}
+	params := endpoints.LeagueDashTeamStatsParams(playerParams)
+	if filter.measureType() == data.MeasureAdvanced {
+		var resp endpoints.LeagueDashTeamAdvancedResponse
+		if err := c.requester.Request(ctx, "leaguedashteamstats", &params, &resp); err != nil {
+			return nil, err
+		}
+		return resp.ToData(), nil
+	}
+	var resp endpoints.LeagueDashTeamStatsResponse
+	if err := c.requester.Request(ctx, "leaguedashteamstats", &params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(), nil
+}
+
+// LeagueLeaders returns the league's leaders in statCategory over the given
+// season, ranked by their totals or averages according to perMode.
+func (c *Client) LeagueLeaders(ctx context.Context, season data.Season, seasonType data.SeasonType, statCategory data.StatCategory, perMode data.PerMode) ([]*data.LeaderEntry, error) {
+	var resp endpoints.LeagueLeadersResponse
+	if err := c.requester.Request(ctx, "leagueleaders", &endpoints.LeagueLeadersParams{
+		LeagueID:     "00",
+		PerMode:      perMode.String(),
+		Scope:        "S",
+		Season:       season.String(),
+		SeasonType:   seasonType.String(),
+		StatCategory: statCategory.String(),
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(statCategory), nil
+}
+
+// DraftHistory returns the draft picks included by filter, ordered by draft
+// and then by pick.
+func (c *Client) DraftHistory(ctx context.Context, filter DraftHistoryFilter) ([]*data.DraftPick, error) {
+	var resp endpoints.DraftHistoryResponse
+	if err := c.requester.Request(ctx, "drafthistory", filter.params(), &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(), nil
+}
+
+// DraftCombine returns the measurements and drill results of every prospect
+// at the draft combine held before the given season.
+func (c *Client) DraftCombine(ctx context.Context, season data.Season) ([]*data.CombineMeasurement, error) {
+	params := &endpoints.DraftCombineParams{
+		LeagueID:   "00",
+		SeasonYear: season.String(),
+	}
+	var anthro endpoints.DraftCombinePlayerAnthroResponse
+	if err := c.requester.Request(ctx, "draftcombineplayeranthro", params, &anthro); err != nil {
+		return nil, err
+	}
+	var stats endpoints.DraftCombineStatsResponse
+	if err := c.requester.Request(ctx, "draftcombinestats", params, &stats); err != nil {
+		return nil, err
+	}
+	return stats.MergeInto(anthro.ToData(season), season), nil
+}
+
 // GamesByDate retrieves all the NBA games happening on the given date.
-func (c *Client) GamesByDate(date time.Time) ([]*data.Game, error) {
+func (c *Client) GamesByDate(ctx context.Context, date time.Time) ([]*data.Game, error) {
 	var resp endpoints.ScoreboardResponse
-	if err := c.requester.Request("scoreboardV2", &endpoints.ScoreboardParams{
+	if err := c.requester.Request(ctx, "scoreboardV2", &endpoints.ScoreboardParams{
 		LeagueID:  "00",
 		DayOffset: 0,
-		GameDate:  date.Format("01/02/2006"),
+		GameDate:  date,
 	}, &resp); err != nil {
 		return nil, err
 	}
 	return resp.ToData()
 }
 
+// Standings returns the standings of every team in the given season. If date
+// isn't zero, the standings are as of that date instead, which must fall in
+// season. Standings as of a date leave TeamName, Streak and LastTen unset,
+// since the scoreboard they're built from doesn't include them.
+func (c *Client) Standings(ctx context.Context, season data.Season, date time.Time) ([]*data.Standing, error) {
+	if !date.IsZero() {
+		if dateSeason := data.SeasonOf(date); dateSeason != season {
+			return nil, fmt.Errorf("nbagame: %v is in the %v season, not %v", data.Date(date), dateSeason, season)
+		}
+		var resp endpoints.ScoreboardResponse
+		if err := c.requester.Request(ctx, "scoreboardV2", &endpoints.ScoreboardParams{
+			LeagueID:  "00",
+			DayOffset: 0,
+			GameDate:  date,
+		}, &resp); err != nil {
+			return nil, err
+		}
+		return resp.Standings(), nil
+	}
+
+	var resp endpoints.LeagueStandingsResponse
+	if err := c.requester.Request(ctx, "leaguestandingsv3", &endpoints.LeagueStandingsParams{
+		LeagueID:   "00",
+		Season:     season.String(),
+		SeasonType: data.RegularSeason.String(),
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToData(), nil
+}
+
 // GamesPlayedBy returns the IDs of all games played by the given team so far
 // in the provided season. Unfortunately, the stats.nba.com API does not
 // provide upcoming games.
-func (c *Client) GamesPlayedBy(season data.Season, teamID int) ([]data.GameID, error) {
+func (c *Client) GamesPlayedBy(ctx context.Context, season data.Season, teamID int) ([]data.GameID, error) {
 	gameIDSet := map[data.GameID]struct{}{}
 
 	var resp endpoints.TeamGameLogResponse
 
 	// Regular season games
-	if err := c.requester.Request("teamgamelog", &endpoints.TeamGameLogParams{
+	if err := c.requester.Request(ctx, "teamgamelog", &endpoints.TeamGameLogParams{
 		LeagueID:   "00",
 		TeamID:     teamID,
 		Season:     season.String(),
@@ -196,7 +479,7 @@ func (c *Client) GamesPlayedBy(season data.Season, teamID int) ([]data.GameID, e
 	}
 
 	// Playoff games
-	if err := c.requester.Request("teamgamelog", &endpoints.TeamGameLogParams{
+	if err := c.requester.Request(ctx, "teamgamelog", &endpoints.TeamGameLogParams{
 		LeagueID:   "00",
 		TeamID:     teamID,
 		Season:     season.String(),
@@ -1,18 +1,26 @@
 package nbagame
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/jbowens/nbagame/data"
+	"github.com/jbowens/nbagame/endpoints"
 )
 
 const (
 	atlantaHawksTeamID = 1610612737
+	jeffTeaguePlayerID = 201952
 	twentyFourteen     = data.Season("2014-15")
 )
 
+// testClient replays the synthetic responses in testdata. Run the tests with
+// NBAGAME_RECORD=1 to record them from stats.nba.com instead.
+var testClient = NewClient(WithRecorder(endpoints.NewRecorder("testdata", endpoints.RecorderModeFromEnv())))
+
 func TestGetHistoricalPlayers(t *testing.T) {
-	allPlayers, err := DefaultClient.HistoricalPlayers()
+	allPlayers, err := testClient.HistoricalPlayers(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -23,7 +31,7 @@ func TestGetHistoricalPlayers(t *testing.T) {
 }
 
 func TestGetGamesPlayedByTeam(t *testing.T) {
-	gameIDs, err := DefaultClient.GamesPlayedBy(twentyFourteen, atlantaHawksTeamID)
+	gameIDs, err := testClient.GamesPlayedBy(context.Background(), twentyFourteen, atlantaHawksTeamID)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -34,3 +42,171 @@ func TestGetGamesPlayedByTeam(t *testing.T) {
 		t.Errorf("Expected 50 or more games, but got %v", len(gameIDs))
 	}
 }
+
+func TestPlayerGameLogDateRange(t *testing.T) {
+	from := time.Date(2015, time.January, 1, 0, 0, 0, 0, endpoints.EastCoast)
+	to := time.Date(2015, time.January, 31, 0, 0, 0, 0, endpoints.EastCoast)
+	games, err := testClient.PlayerGameLog(context.Background(), jeffTeaguePlayerID, twentyFourteen, GameLogFilter{
+		SeasonType: data.RegularSeason,
+		DateFrom:   from,
+		DateTo:     to,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(games) == 0 {
+		t.Fatal("expected games in January")
+	}
+	for _, game := range games {
+		if date := time.Time(game.Date); date.Before(from) || date.After(to) {
+			t.Errorf("expected a game in January, got %v", game.Date)
+		}
+		if game.PlayerID != jeffTeaguePlayerID {
+			t.Errorf("expected a Jeff Teague game, got %+v", game)
+		}
+	}
+}
+
+func TestStandingsAsOfDate(t *testing.T) {
+	date := time.Date(2015, time.April, 13, 0, 0, 0, 0, endpoints.EastCoast)
+	standings, err := testClient.Standings(context.Background(), twentyFourteen, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(standings) != 30 {
+		t.Fatalf("expected 30 teams, got %v", len(standings))
+	}
+	for _, standing := range standings {
+		if standing.TeamID == atlantaHawksTeamID && (standing.ConferenceRank != 1 || standing.Wins != 60) {
+			t.Errorf("expected the Hawks to lead the East with 60 wins, got %+v", standing)
+		}
+	}
+}
+
+func TestStandingsDateOutsideSeason(t *testing.T) {
+	date := time.Date(2015, time.November, 1, 0, 0, 0, 0, endpoints.EastCoast)
+	if _, err := testClient.Standings(context.Background(), twentyFourteen, date); err == nil {
+		t.Error("expected an error for a date outside the season")
+	}
+}
+
+func TestShotChartForGame(t *testing.T) {
+	chart, err := testClient.ShotChart(context.Background(), ShotChartFilter{
+		PlayerID: 2406,
+		TeamID:   1610612765,
+		GameID:   "0021401203",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chart.Shots) == 0 || len(chart.LeagueAverages) == 0 {
+		t.Fatalf("expected shots and league averages, got %+v", chart)
+	}
+	for i, shot := range chart.Shots {
+		if shot.GameID != "0021401203" || shot.PlayerID != 2406 {
+			t.Errorf("unexpected shot: %+v", shot)
+		}
+		if i > 0 && shot.Period < chart.Shots[i-1].Period {
+			t.Errorf("expected shots to be sorted by period, got %v after %v", shot.Period, chart.Shots[i-1].Period)
+		}
+		if chart.LeagueAverage(shot.ShotZone) == nil {
+			t.Errorf("expected a league average for %+v", shot.ShotZone)
+		}
+	}
+}
+
+func TestLeagueTeamStatsAtHome(t *testing.T) {
+	teams, err := testClient.LeagueTeamStats(context.Background(), LeagueStatsFilter{
+		Season:   twentyFourteen,
+		PerMode:  data.Per100Possessions,
+		Location: data.LocationHome,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(teams) != 30 {
+		t.Fatalf("expected 30 teams, got %v", len(teams))
+	}
+	for _, team := range teams {
+		if team.GamesPlayed != 41 || team.Stats == nil || team.Stats.Points < 90 {
+			t.Errorf("expected per 100 possession stats over 41 home games, got %+v", team)
+		}
+	}
+}
+
+func TestLeagueStatsUnsupportedMeasureType(t *testing.T) {
+	_, err := testClient.LeaguePlayerStats(context.Background(), LeagueStatsFilter{
+		MeasureType: data.MeasureType("Clutch"),
+	})
+	if err == nil {
+		t.Error("expected an error for an unsupported measure type")
+	}
+	_, err = testClient.LeagueTeamStats(context.Background(), LeagueStatsFilter{
+		MeasureType: data.MeasureType("Clutch"),
+	})
+	if err == nil {
+		t.Error("expected an error for an unsupported measure type")
+	}
+}
+
+func TestLeagueLeadersInEfficiency(t *testing.T) {
+	leaders, err := testClient.LeagueLeaders(context.Background(), twentyFourteen, data.RegularSeason, data.CategoryEfficiency, data.Totals)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(leaders) == 0 {
+		t.Fatal("expected leaders")
+	}
+	for i, leader := range leaders {
+		if leader.Category != data.CategoryEfficiency || leader.Value != leader.Efficiency {
+			t.Errorf("expected leaders by efficiency, got %+v", leader)
+		}
+		if i > 0 && (leader.Rank < leaders[i-1].Rank || leader.Value > leaders[i-1].Value) {
+			t.Errorf("expected leaders to be sorted by efficiency, got %+v after %+v", leader, leaders[i-1])
+		}
+	}
+	if leaders[0].Rank != 1 || leaders[0].PlayerID == 0 || leaders[0].GamesPlayed == 0 {
+		t.Errorf("expected the efficiency leader to be ranked first, got %+v", leaders[0])
+	}
+}
+
+func TestDraftHistoryLinksPlayers(t *testing.T) {
+	picks, err := testClient.DraftHistory(context.Background(), DraftHistoryFilter{
+		Year:  2014,
+		Round: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mcDermott *data.DraftPick
+	for _, pick := range picks {
+		if pick.PlayerID == 203926 {
+			mcDermott = pick
+		}
+	}
+	if mcDermott == nil || mcDermott.OverallPick != 11 || mcDermott.TeamAbbreviation != "DEN" {
+		t.Errorf("expected Doug McDermott to be picked 11th by Denver, got %+v", mcDermott)
+	}
+}
+
+func TestDraftCombine(t *testing.T) {
+	measurements, err := testClient.DraftCombine(context.Background(), twentyFourteen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(measurements) == 0 {
+		t.Fatal("expected prospects")
+	}
+	for _, m := range measurements {
+		if m.Wingspan == nil || *m.Wingspan < *m.HeightWithoutShoes {
+			t.Errorf("expected a wingspan longer than the prospect's height, got %+v", m)
+		}
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
 
 	"github.com/jbowens/nbagame/data"
 	"github.com/jbowens/nbagame/db/sync"
+	"github.com/jbowens/nbagame/endpoints"
 )
 
 const (
@@ -16,11 +19,22 @@ const (
 
 var (
 	seasonFlag = flag.String("season", data.CurrentSeason.String(), "the season to sync")
+	rpsFlag    = flag.Float64("rps", endpoints.DefaultRequestsPerSecond, "the maximum number of requests per second")
+	burstFlag  = flag.Int("burst", endpoints.DefaultBurst, "the maximum burst of requests")
+	cacheFlag  = flag.String("cache", "", "a directory in which to cache responses between runs")
 )
 
 func main() {
 	flag.Parse()
 	season := data.Season(*seasonFlag)
+	endpoints.DefaultRateLimiter.SetLimit(*rpsFlag, *burstFlag)
+	if *cacheFlag != "" {
+		cache, err := endpoints.NewDiskCache(*cacheFlag)
+		if err != nil {
+			fatal(err)
+		}
+		endpoints.DefaultRequester.Cache = cache
+	}
 
 	// Figure out what we should sync based on the arguments.
 	var syncTeams, syncPlayers, syncGames, syncPlays bool
@@ -43,13 +57,17 @@ func main() {
 		}
 	}
 
+	// Stop syncing cleanly if we're interrupted.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
 	syncer, err := sync.New(defaultDatabaseEnvironment, "./db")
 	if err != nil {
 		fatal(err)
 	}
 
 	if syncTeams {
-		count, err := syncer.SyncAllTeams()
+		count, err := syncer.SyncAllTeams(ctx)
 		if err != nil {
 			fatal(err)
 		}
@@ -57,7 +75,7 @@ func main() {
 	}
 
 	if syncPlayers {
-		count, err := syncer.SyncAllPlayers()
+		count, err := syncer.SyncAllPlayers(ctx)
 		if err != nil {
 			fatal(err)
 		}
@@ -65,7 +83,7 @@ func main() {
 	}
 
 	if syncGames {
-		count, err := syncer.SyncAllGames(season)
+		count, err := syncer.SyncAllGames(ctx, season)
 		if err != nil {
 			fatal(err)
 		}
@@ -73,7 +91,7 @@ func main() {
 	}
 
 	if syncPlays {
-		count, err := syncer.SyncAllGamesPlayByPlay(season)
+		count, err := syncer.SyncAllGamesPlayByPlay(ctx, season)
 		if err != nil {
 			fatal(err)
 		}
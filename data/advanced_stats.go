@@ -0,0 +1,69 @@
+package data
+
+// AdvancedBoxScore holds an individual game's advanced box score.
+type AdvancedBoxScore struct {
+	TeamStats   []*TeamAdvancedStats   `json:"team"`
+	PlayerStats []*PlayerAdvancedStats `json:"player"`
+}
+
+// Team returns the advanced stats for the team with the given ID. If no
+// team with the given ID played in the game, then nil is returned.
+func (box *AdvancedBoxScore) Team(teamID int) *TeamAdvancedStats {
+	for _, teamStats := range box.TeamStats {
+		if teamStats.TeamID == teamID {
+			return teamStats
+		}
+	}
+	return nil
+}
+
+// Player returns the advanced stats for the player with the given ID. If
+// the player with the given ID did not play in the game, then nil is
+// returned.
+func (box *AdvancedBoxScore) Player(playerID int) *PlayerAdvancedStats {
+	for _, playerStats := range box.PlayerStats {
+		if playerStats.PlayerID == playerID {
+			return playerStats
+		}
+	}
+	return nil
+}
+
+// TeamAdvancedStats contains a team's advanced stats line.
+type TeamAdvancedStats struct {
+	TeamID           int    `json:"id"`
+	TeamName         string `json:"team_name"`
+	TeamAbbreviation string `json:"team_abbreviation"`
+	TeamCity         string `json:"team_city"`
+	AdvancedStats
+}
+
+// PlayerAdvancedStats contains a player's advanced stats line.
+type PlayerAdvancedStats struct {
+	PlayerID   int    `json:"id"`
+	PlayerName string `json:"player_name"`
+	TeamID     int    `json:"team_id"`
+	AdvancedStats
+}
+
+// AdvancedStats contains an advanced stat line, derived from the box score
+// and the number of possessions played. Ratings are per 100 possessions,
+// and percentages are fractions between 0 and 1.
+type AdvancedStats struct {
+	SecondsPlayed                int     `json:"seconds_played"`
+	OffensiveRating              float64 `json:"offensive_rating"`
+	DefensiveRating              float64 `json:"defensive_rating"`
+	NetRating                    float64 `json:"net_rating"`
+	AssistPercentage             float64 `json:"assist_percentage"`
+	AssistToTurnoverRatio        float64 `json:"assist_to_turnover_ratio"`
+	AssistRatio                  float64 `json:"assist_ratio"`
+	OffensiveReboundPercentage   float64 `json:"offensive_rebound_percentage"`
+	DefensiveReboundPercentage   float64 `json:"defensive_rebound_percentage"`
+	ReboundPercentage            float64 `json:"rebound_percentage"`
+	TurnoverRatio                float64 `json:"turnover_ratio"`
+	EffectiveFieldGoalPercentage float64 `json:"effective_field_goal_percentage"`
+	TrueShootingPercentage       float64 `json:"true_shooting_percentage"`
+	UsagePercentage              float64 `json:"usage_percentage"`
+	Pace                         float64 `json:"pace"`
+	PIE                          float64 `json:"pie"`
+}
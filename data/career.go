@@ -0,0 +1,50 @@
+package data
+
+// PlayerCareer holds a player's stats for each season of their career, and
+// over their whole career, in the NBA and in college.
+type PlayerCareer struct {
+	PlayerID int     `json:"player_id"`
+	PerMode  PerMode `json:"per_mode"`
+
+	RegularSeason []*PlayerSeason        `json:"regular_season"`
+	PostSeason    []*PlayerSeason        `json:"post_season"`
+	AllStar       []*PlayerSeason        `json:"all_star"`
+	College       []*PlayerCollegeSeason `json:"college"`
+
+	CareerRegularSeason *AggregateStats `json:"career_regular_season,omitempty"`
+	CareerPostSeason    *AggregateStats `json:"career_post_season,omitempty"`
+	CareerAllStar       *AggregateStats `json:"career_all_star,omitempty"`
+	CareerCollege       *AggregateStats `json:"career_college,omitempty"`
+}
+
+// Season returns the player's regular season stats for the given season. If
+// the player didn't play in the season, then nil is returned.
+func (c *PlayerCareer) Season(season Season) *PlayerSeason {
+	for _, s := range c.RegularSeason {
+		if s.Season == season {
+			return s
+		}
+	}
+	return nil
+}
+
+// PlayerSeason contains a player's stats over a season. If the player played
+// for more than one team during the season, TeamID is zero and the stats for
+// each of the teams are in TeamSplits.
+type PlayerSeason struct {
+	Season           Season  `json:"season"`
+	TeamID           int     `json:"team_id"`
+	TeamAbbreviation string  `json:"team_abbreviation"`
+	PlayerAge        float64 `json:"player_age"`
+	AggregateStats
+	TeamSplits []*PlayerSeason `json:"team_splits,omitempty"`
+}
+
+// PlayerCollegeSeason contains a player's stats over a college season.
+type PlayerCollegeSeason struct {
+	Season         Season  `json:"season"`
+	OrganizationID int     `json:"organization_id"`
+	SchoolName     string  `json:"school_name"`
+	PlayerAge      float64 `json:"player_age"`
+	AggregateStats
+}
@@ -0,0 +1,46 @@
+package data
+
+// DraftPick is a player's selection in the NBA draft. PlayerID is the same ID
+// as the player's Player and PlayerDetails.
+type DraftPick struct {
+	PlayerID         int    `json:"player_id"`
+	PlayerName       string `json:"player_name"`
+	Year             int    `json:"year"`
+	Round            int    `json:"round"`
+	RoundPick        int    `json:"round_pick"`
+	OverallPick      int    `json:"overall_pick"`
+	TeamID           int    `json:"team_id"`
+	TeamCity         string `json:"team_city"`
+	TeamName         string `json:"team_name"`
+	TeamAbbreviation string `json:"team_abbreviation"`
+	Organization     string `json:"organization,omitempty"`
+	OrganizationType string `json:"organization_type,omitempty"`
+}
+
+// CombineMeasurement holds a prospect's measurements and drill results from
+// the draft combine. Lengths are in inches, weight in pounds and times in
+// seconds. Measurements and drills the prospect skipped are nil.
+type CombineMeasurement struct {
+	PlayerID   int    `json:"player_id"`
+	Season     Season `json:"season"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	PlayerName string `json:"player_name"`
+	Position   string `json:"position"`
+
+	HeightWithoutShoes *float64 `json:"height_without_shoes,omitempty"`
+	HeightWithShoes    *float64 `json:"height_with_shoes,omitempty"`
+	Weight             *float64 `json:"weight,omitempty"`
+	Wingspan           *float64 `json:"wingspan,omitempty"`
+	StandingReach      *float64 `json:"standing_reach,omitempty"`
+	BodyFatPercentage  *float64 `json:"body_fat_percentage,omitempty"`
+	HandLength         *float64 `json:"hand_length,omitempty"`
+	HandWidth          *float64 `json:"hand_width,omitempty"`
+
+	StandingVerticalLeap    *float64 `json:"standing_vertical_leap,omitempty"`
+	MaxVerticalLeap         *float64 `json:"max_vertical_leap,omitempty"`
+	LaneAgilityTime         *float64 `json:"lane_agility_time,omitempty"`
+	ModifiedLaneAgilityTime *float64 `json:"modified_lane_agility_time,omitempty"`
+	ThreeQuarterSprint      *float64 `json:"three_quarter_sprint,omitempty"`
+	BenchPress              *int     `json:"bench_press,omitempty"`
+}
@@ -0,0 +1,169 @@
+package data
+
+// FullBoxScore merges every variant of an individual game's box score into
+// one stat line per team and player. Variants that haven't been merged in
+// are nil.
+type FullBoxScore struct {
+	TeamStats   []*FullTeamStats   `json:"team"`
+	PlayerStats []*FullPlayerStats `json:"player"`
+}
+
+// Team returns the stats for the team with the given ID. If no team with the
+// given ID played in the game, then nil is returned.
+func (box *FullBoxScore) Team(teamID int) *FullTeamStats {
+	for _, teamStats := range box.TeamStats {
+		if teamStats.TeamID == teamID {
+			return teamStats
+		}
+	}
+	return nil
+}
+
+// Player returns the stats for the player with the given ID. If the player
+// with the given ID did not play in the game, then nil is returned.
+func (box *FullBoxScore) Player(playerID int) *FullPlayerStats {
+	for _, playerStats := range box.PlayerStats {
+		if playerStats.PlayerID == playerID {
+			return playerStats
+		}
+	}
+	return nil
+}
+
+// AddTeam returns the stats for the team with the given ID, adding them to
+// the box score if they're not there yet.
+func (box *FullBoxScore) AddTeam(teamID int, name, abbreviation, city string) *FullTeamStats {
+	if teamStats := box.Team(teamID); teamStats != nil {
+		return teamStats
+	}
+	teamStats := &FullTeamStats{
+		TeamID:           teamID,
+		TeamName:         name,
+		TeamAbbreviation: abbreviation,
+		TeamCity:         city,
+	}
+	box.TeamStats = append(box.TeamStats, teamStats)
+	return teamStats
+}
+
+// AddPlayer returns the stats for the player with the given ID, adding them
+// to the box score if they're not there yet.
+func (box *FullBoxScore) AddPlayer(playerID int, name string, teamID int) *FullPlayerStats {
+	if playerStats := box.Player(playerID); playerStats != nil {
+		return playerStats
+	}
+	playerStats := &FullPlayerStats{
+		PlayerID:   playerID,
+		PlayerName: name,
+		TeamID:     teamID,
+	}
+	box.PlayerStats = append(box.PlayerStats, playerStats)
+	return playerStats
+}
+
+// FullTeamStats contains each of a team's box score stat lines.
+type FullTeamStats struct {
+	TeamID           int    `json:"id"`
+	TeamName         string `json:"team_name"`
+	TeamAbbreviation string `json:"team_abbreviation"`
+	TeamCity         string `json:"team_city"`
+	FullStats
+}
+
+// FullPlayerStats contains each of a player's box score stat lines.
+type FullPlayerStats struct {
+	PlayerID   int    `json:"id"`
+	PlayerName string `json:"player_name"`
+	TeamID     int    `json:"team_id"`
+	FullStats
+}
+
+// FullStats contains a stat line from each box score variant.
+type FullStats struct {
+	Traditional *Stats            `json:"traditional,omitempty"`
+	Advanced    *AdvancedStats    `json:"advanced,omitempty"`
+	FourFactors *FourFactorsStats `json:"four_factors,omitempty"`
+	Misc        *MiscStats        `json:"misc,omitempty"`
+	Scoring     *ScoringStats     `json:"scoring,omitempty"`
+	Usage       *UsageStats       `json:"usage,omitempty"`
+}
+
+// FourFactorsStats contains the four factors stat line: shooting, turnovers,
+// offensive rebounding and getting to the free throw line, for and against.
+// Percentages are fractions between 0 and 1.
+type FourFactorsStats struct {
+	SecondsPlayed                        int     `json:"seconds_played"`
+	EffectiveFieldGoalPercentage         float64 `json:"effective_field_goal_percentage"`
+	FreeThrowAttemptRate                 float64 `json:"free_throw_attempt_rate"`
+	TurnoverRatio                        float64 `json:"turnover_ratio"`
+	OffensiveReboundPercentage           float64 `json:"offensive_rebound_percentage"`
+	OpponentEffectiveFieldGoalPercentage float64 `json:"opponent_effective_field_goal_percentage"`
+	OpponentFreeThrowAttemptRate         float64 `json:"opponent_free_throw_attempt_rate"`
+	OpponentTurnoverRatio                float64 `json:"opponent_turnover_ratio"`
+	OpponentOffensiveReboundPercentage   float64 `json:"opponent_offensive_rebound_percentage"`
+}
+
+// MiscStats contains the misc stat line: where points came from, for and
+// against, and blocks and fouls, committed and drawn.
+type MiscStats struct {
+	SecondsPlayed              int `json:"seconds_played"`
+	PointsOffTurnovers         int `json:"points_off_turnovers"`
+	SecondChancePoints         int `json:"second_chance_points"`
+	FastBreakPoints            int `json:"fast_break_points"`
+	PointsInPaint              int `json:"points_in_paint"`
+	OpponentPointsOffTurnovers int `json:"opponent_points_off_turnovers"`
+	OpponentSecondChancePoints int `json:"opponent_second_chance_points"`
+	OpponentFastBreakPoints    int `json:"opponent_fast_break_points"`
+	OpponentPointsInPaint      int `json:"opponent_points_in_paint"`
+	Blocks                     int `json:"blocks"`
+	BlocksAgainst              int `json:"blocks_against"`
+	PersonalFouls              int `json:"personal_fouls"`
+	PersonalFoulsDrawn         int `json:"personal_fouls_drawn"`
+}
+
+// ScoringStats contains the scoring stat line: the share of field goal
+// attempts and points of each kind, and how many field goals were assisted.
+// Percentages are fractions between 0 and 1.
+type ScoringStats struct {
+	SecondsPlayed                      int     `json:"seconds_played"`
+	PercentFieldGoalsAttemptedTwo      float64 `json:"percent_field_goals_attempted_two"`
+	PercentFieldGoalsAttemptedThree    float64 `json:"percent_field_goals_attempted_three"`
+	PercentPointsTwo                   float64 `json:"percent_points_two"`
+	PercentPointsMidRange              float64 `json:"percent_points_mid_range"`
+	PercentPointsThree                 float64 `json:"percent_points_three"`
+	PercentPointsFastBreak             float64 `json:"percent_points_fast_break"`
+	PercentPointsFreeThrow             float64 `json:"percent_points_free_throw"`
+	PercentPointsOffTurnovers          float64 `json:"percent_points_off_turnovers"`
+	PercentPointsInPaint               float64 `json:"percent_points_in_paint"`
+	PercentAssistedTwoPointersMade     float64 `json:"percent_assisted_two_pointers_made"`
+	PercentUnassistedTwoPointersMade   float64 `json:"percent_unassisted_two_pointers_made"`
+	PercentAssistedThreePointersMade   float64 `json:"percent_assisted_three_pointers_made"`
+	PercentUnassistedThreePointersMade float64 `json:"percent_unassisted_three_pointers_made"`
+	PercentAssistedFieldGoalsMade      float64 `json:"percent_assisted_field_goals_made"`
+	PercentUnassistedFieldGoalsMade    float64 `json:"percent_unassisted_field_goals_made"`
+}
+
+// UsageStats contains the usage stat line: the share of the team's stats
+// recorded while on the court. Percentages are fractions between 0 and 1,
+// and are all 1 for a team.
+type UsageStats struct {
+	SecondsPlayed                 int     `json:"seconds_played"`
+	UsagePercentage               float64 `json:"usage_percentage"`
+	PercentFieldGoalsMade         float64 `json:"percent_field_goals_made"`
+	PercentFieldGoalsAttempted    float64 `json:"percent_field_goals_attempted"`
+	PercentThreePointersMade      float64 `json:"percent_three_pointers_made"`
+	PercentThreePointersAttempted float64 `json:"percent_three_pointers_attempted"`
+	PercentFreeThrowsMade         float64 `json:"percent_free_throws_made"`
+	PercentFreeThrowsAttempted    float64 `json:"percent_free_throws_attempted"`
+	PercentOffensiveRebounds      float64 `json:"percent_offensive_rebounds"`
+	PercentDefensiveRebounds      float64 `json:"percent_defensive_rebounds"`
+	PercentRebounds               float64 `json:"percent_rebounds"`
+	PercentAssists                float64 `json:"percent_assists"`
+	PercentTurnovers              float64 `json:"percent_turnovers"`
+	PercentSteals                 float64 `json:"percent_steals"`
+	PercentBlocks                 float64 `json:"percent_blocks"`
+	PercentBlocksAgainst          float64 `json:"percent_blocks_against"`
+	PercentPersonalFouls          float64 `json:"percent_personal_fouls"`
+	PercentPersonalFoulsDrawn     float64 `json:"percent_personal_fouls_drawn"`
+	PercentPoints                 float64 `json:"percent_points"`
+}
@@ -3,6 +3,8 @@ package data
 import (
 	"database/sql/driver"
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -45,6 +47,25 @@ func (id GameID) IsPlayoff() bool {
 	return strings.HasPrefix(string(id), "004")
 }
 
+// Season returns the season the game belongs to. Game IDs encode the
+// last two digits of the season's fall year, ex. "0021401185" was played
+// during the 2014-15 season.
+func (id GameID) Season() Season {
+	if len(id) < 5 {
+		return ""
+	}
+	year, err := strconv.Atoi(string(id[3:5]))
+	if err != nil {
+		return ""
+	}
+	if year < 46 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	return Season(fmt.Sprintf("%d-%s", year, strconv.Itoa(year + 1)[2:]))
+}
+
 // Date is a wrapper around a time.Time, but only displays
 // the date portion when serialized as JSON.
 type Date time.Time
@@ -0,0 +1,12 @@
+package data
+
+// PlayerGameLogEntry is a player's stat line from one game of a game log.
+type PlayerGameLogEntry struct {
+	PlayerID int        `json:"player_id"`
+	GameID   GameID     `json:"game_id"`
+	Date     Date       `json:"date"`
+	Matchup  string     `json:"matchup"`
+	Location HomeOrAway `json:"location"`
+	Won      bool       `json:"won"`
+	Stats
+}
@@ -0,0 +1,17 @@
+package data
+
+import "testing"
+
+func TestGameIDSeason(t *testing.T) {
+	testCases := map[GameID]Season{
+		"0021401185": "2014-15",
+		"0049900001": "1999-00",
+		"0029600712": "1996-97",
+		"002":        "",
+	}
+	for id, expected := range testCases {
+		if season := id.Season(); season != expected {
+			t.Errorf("expected %s to be in season %q, got %q", id, expected, season)
+		}
+	}
+}
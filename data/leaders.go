@@ -0,0 +1,16 @@
+package data
+
+// LeaderEntry is a player's place among the league's leaders in a stat
+// category. Value is the player's value in the category, and the rest of
+// their stats are totals or averages according to the PerMode the leaders
+// were retrieved with.
+type LeaderEntry struct {
+	Rank             int          `json:"rank"`
+	PlayerID         int          `json:"player_id"`
+	PlayerName       string       `json:"player_name"`
+	TeamAbbreviation string       `json:"team_abbreviation"`
+	Category         StatCategory `json:"category"`
+	Value            float64      `json:"value"`
+	AggregateStats
+	Efficiency float64 `json:"efficiency"`
+}
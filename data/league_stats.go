@@ -0,0 +1,36 @@
+package data
+
+// LeaguePlayerStats contains a player's stats aggregated over the games
+// matching a league dashboard filter. Stats is set when the filter's
+// measure type is MeasureBase, and Advanced when it's MeasureAdvanced.
+type LeaguePlayerStats struct {
+	PlayerID         int     `json:"player_id"`
+	PlayerName       string  `json:"player_name"`
+	TeamID           int     `json:"team_id"`
+	TeamAbbreviation string  `json:"team_abbreviation"`
+	Age              float64 `json:"age"`
+	GamesPlayed      int     `json:"games_played"`
+	Wins             int     `json:"wins"`
+	Losses           int     `json:"losses"`
+	WinPercentage    float64 `json:"win_percentage"`
+
+	Stats     *AggregateStats `json:"stats,omitempty"`
+	PlusMinus float64         `json:"plus_minus"`
+	Advanced  *AdvancedStats  `json:"advanced,omitempty"`
+}
+
+// LeagueTeamStats contains a team's stats aggregated over the games
+// matching a league dashboard filter. Stats is set when the filter's
+// measure type is MeasureBase, and Advanced when it's MeasureAdvanced.
+type LeagueTeamStats struct {
+	TeamID        int     `json:"team_id"`
+	TeamName      string  `json:"team_name"`
+	GamesPlayed   int     `json:"games_played"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	WinPercentage float64 `json:"win_percentage"`
+
+	Stats     *AggregateStats `json:"stats,omitempty"`
+	PlusMinus float64         `json:"plus_minus"`
+	Advanced  *AdvancedStats  `json:"advanced,omitempty"`
+}
@@ -41,7 +41,8 @@ type PlayerDescription struct {
 	TeamID int    `json:"team_id"`
 }
 
-// PlayerDetails contains detailed information about an NBA player.
+// PlayerDetails contains detailed information about an NBA player. The draft
+// fields are zero for undrafted players.
 type PlayerDetails struct {
 	PlayerID         int          `json:"id" db:"id"`
 	FirstName        string       `json:"first_name" db:"first_name"`
@@ -62,4 +63,7 @@ type PlayerDetails struct {
 	CareerStartYear  string       `json:"career_start_year" db:"career_start"`
 	CareerEndYear    string       `json:"career_end_year" db:"career_end"`
 	DLeague          bool         `json:"dleague" db:"dleague"`
+	DraftYear        int          `json:"draft_year,omitempty" db:"draft_year"`
+	DraftRound       int          `json:"draft_round,omitempty" db:"draft_round"`
+	DraftNumber      int          `json:"draft_number,omitempty" db:"draft_number"`
 }
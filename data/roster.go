@@ -0,0 +1,45 @@
+package data
+
+// Roster holds the players and coaching staff on a team during a season.
+type Roster struct {
+	TeamID  int            `json:"team_id"`
+	Season  Season         `json:"season"`
+	Players []*RosterEntry `json:"players"`
+	Coaches []*Coach       `json:"coaches"`
+}
+
+// RosterEntry is a player on a team's roster.
+type RosterEntry struct {
+	PlayerID   int     `json:"player_id"`
+	PlayerName string  `json:"player_name"`
+	TeamID     int     `json:"team_id"`
+	Season     Season  `json:"season"`
+	Jersey     string  `json:"jersey,omitempty"`
+	Position   string  `json:"position,omitempty"`
+	Height     int     `json:"height,omitempty"`
+	Weight     int     `json:"weight,omitempty"`
+	Birthdate  *Date   `json:"birthdate,omitempty"`
+	Age        float64 `json:"age,omitempty"`
+	// Experience is the number of seasons the player had played in the
+	// NBA before this one.
+	Experience  int    `json:"experience"`
+	School      string `json:"school,omitempty"`
+	HowAcquired string `json:"how_acquired,omitempty"`
+}
+
+// Coach is a member of a team's coaching staff.
+type Coach struct {
+	CoachID   int    `json:"coach_id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	TeamID    int    `json:"team_id"`
+	Season    Season `json:"season"`
+	// Type is the coach's role, ex. "Head Coach" or "Assistant Coach".
+	Type   string `json:"type"`
+	School string `json:"school,omitempty"`
+}
+
+// HeadCoach returns true if the coach is the team's head coach.
+func (c *Coach) HeadCoach() bool {
+	return c.Type == "Head Coach"
+}
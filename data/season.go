@@ -20,16 +20,17 @@ var (
 )
 
 func init() {
-	now := time.Now()
-	year := now.Year()
-
-	var seasonStr string
-	if now.Month() >= time.July {
-		seasonStr = fmt.Sprintf("%d-%s", year, strconv.Itoa(year + 1)[2:])
-	} else {
-		seasonStr = fmt.Sprintf("%d-%s", year-1, strconv.Itoa(year)[2:])
+	CurrentSeason = SeasonOf(time.Now())
+}
+
+// SeasonOf returns the season that the given date falls in. Seasons switch
+// starting on July 1st.
+func SeasonOf(date time.Time) Season {
+	year := date.Year()
+	if date.Month() >= time.July {
+		return Season(fmt.Sprintf("%d-%s", year, strconv.Itoa(year + 1)[2:]))
 	}
-	CurrentSeason = Season(seasonStr)
+	return Season(fmt.Sprintf("%d-%s", year-1, strconv.Itoa(year)[2:]))
 }
 
 func (s Season) Value() (driver.Value, error) {
@@ -90,3 +91,18 @@ func (s Season) Next() Season {
 func (s Season) String() string {
 	return string(s)
 }
+
+// SeasonType identifies a part of a season. These are used as parameters for
+// many API endpoints.
+type SeasonType string
+
+const (
+	PreSeason     SeasonType = "Pre Season"
+	RegularSeason SeasonType = "Regular Season"
+	Playoffs      SeasonType = "Playoffs"
+	AllStar       SeasonType = "All Star"
+)
+
+func (t SeasonType) String() string {
+	return string(t)
+}
@@ -1,6 +1,9 @@
 package data
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestNextSeason(t *testing.T) {
 	var thisSeason Season = "2014-15"
@@ -10,3 +13,20 @@ func TestNextSeason(t *testing.T) {
 		t.Errorf("Expected next season to be 2015-16, but got `%s`", nextSeason)
 	}
 }
+
+func TestSeasonOf(t *testing.T) {
+	tests := []struct {
+		date   time.Time
+		season Season
+	}{
+		{time.Date(2015, time.April, 13, 0, 0, 0, 0, time.UTC), "2014-15"},
+		{time.Date(2015, time.June, 30, 0, 0, 0, 0, time.UTC), "2014-15"},
+		{time.Date(2015, time.July, 1, 0, 0, 0, 0, time.UTC), "2015-16"},
+		{time.Date(1999, time.November, 2, 0, 0, 0, 0, time.UTC), "1999-00"},
+	}
+	for _, test := range tests {
+		if season := SeasonOf(test.date); season != test.season {
+			t.Errorf("expected %v to be in the %v season, got %v", test.date, test.season, season)
+		}
+	}
+}
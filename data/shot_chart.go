@@ -0,0 +1,58 @@
+package data
+
+// ShotChart holds shot attempts, with the league's averages from each zone
+// of the court to compare them against.
+type ShotChart struct {
+	Shots          []*ShotAttempt     `json:"shots"`
+	LeagueAverages []*ShotZoneAverage `json:"league_averages"`
+}
+
+// LeagueAverage returns the league's average from the given zone. If there's
+// no average for the zone, then nil is returned.
+func (c *ShotChart) LeagueAverage(zone ShotZone) *ShotZoneAverage {
+	for _, average := range c.LeagueAverages {
+		if average.ShotZone == zone {
+			return average
+		}
+	}
+	return nil
+}
+
+// ShotAttempt describes where and when a shot was attempted.
+type ShotAttempt struct {
+	GameID      GameID `json:"game_id"`
+	GameEventID int    `json:"game_event_id"`
+	PlayerID    int    `json:"player_id"`
+	PlayerName  string `json:"player_name"`
+	TeamID      int    `json:"team_id"`
+	TeamName    string `json:"team_name"`
+	Period      int    `json:"period"`
+	// SecondsRemaining is the time left on the clock in the period.
+	SecondsRemaining int    `json:"seconds_remaining"`
+	ActionType       string `json:"action_type"`
+	PointsAttempted  int    `json:"points_attempted"`
+	Made             bool   `json:"made"`
+	// Distance is the distance of the shot from the basket, in feet.
+	Distance int `json:"distance"`
+	// LocationX and LocationY are where the shot was taken from, in tenths
+	// of a foot from the basket, with Y increasing towards half court.
+	LocationX int `json:"location_x"`
+	LocationY int `json:"location_y"`
+	ShotZone
+}
+
+// ShotZone identifies an area of the court, ex. the "Above the Break 3" in
+// the "Center(C)" from "24+ ft.".
+type ShotZone struct {
+	Basic string `json:"zone_basic"`
+	Area  string `json:"zone_area"`
+	Range string `json:"zone_range"`
+}
+
+// ShotZoneAverage contains the shooting from a zone of the court.
+type ShotZoneAverage struct {
+	ShotZone
+	FieldGoalsAttempted int     `json:"field_goals_attempted"`
+	FieldGoalsMade      int     `json:"field_goals_made"`
+	FieldGoalPercentage float64 `json:"field_goal_percentage"`
+}
@@ -0,0 +1,34 @@
+package data
+
+import "fmt"
+
+// Standing is a team's place in the standings.
+type Standing struct {
+	TeamID         int     `json:"team_id"`
+	TeamCity       string  `json:"team_city"`
+	TeamName       string  `json:"team_name,omitempty"`
+	Conference     string  `json:"conference"`
+	Date           Date    `json:"date"`
+	Wins           int     `json:"wins"`
+	Losses         int     `json:"losses"`
+	WinPercentage  float64 `json:"win_percentage"`
+	GamesBack      float64 `json:"games_back"`
+	ConferenceRank int     `json:"conference_rank"`
+	// Streak is the number of games the team has won in a row, or the
+	// negative number of games it has lost in a row.
+	Streak  int    `json:"streak"`
+	Home    Record `json:"home"`
+	Road    Record `json:"road"`
+	LastTen Record `json:"last_ten"`
+}
+
+// Record is a number of wins and losses.
+type Record struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+}
+
+// String returns the record as it's usually written, ex. "30-11".
+func (r Record) String() string {
+	return fmt.Sprintf("%d-%d", r.Wins, r.Losses)
+}
@@ -76,3 +76,144 @@ func (s *Stats) Calculate() {
 		s.FreeThrowPercentage = &pct
 	}
 }
+
+// PerMode determines how stats aggregated over many games are reported: as
+// totals, or averaged per game, per 36 minutes played or per 100
+// possessions. These are used as parameters for many API endpoints.
+type PerMode string
+
+const (
+	Totals            PerMode = "Totals"
+	PerGame           PerMode = "PerGame"
+	Per36             PerMode = "Per36"
+	Per100Possessions PerMode = "Per100Possessions"
+)
+
+func (m PerMode) String() string {
+	return string(m)
+}
+
+// MeasureType determines which family of stats the league dashboard
+// endpoints report. Only the measure types below are supported.
+type MeasureType string
+
+const (
+	MeasureBase     MeasureType = "Base"
+	MeasureAdvanced MeasureType = "Advanced"
+)
+
+func (m MeasureType) String() string {
+	return string(m)
+}
+
+// Location restricts stats to games played at home or on the road. These
+// are used as parameters for many API endpoints.
+type Location string
+
+const (
+	LocationHome Location = "Home"
+	LocationRoad Location = "Road"
+)
+
+func (l Location) String() string {
+	return string(l)
+}
+
+// Outcome restricts stats to games won or lost.
+type Outcome string
+
+const (
+	OutcomeWin  Outcome = "W"
+	OutcomeLoss Outcome = "L"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// SeasonSegment restricts stats to games played before or after the
+// All-Star break.
+type SeasonSegment string
+
+const (
+	PreAllStar  SeasonSegment = "Pre All-Star"
+	PostAllStar SeasonSegment = "Post All-Star"
+)
+
+func (s SeasonSegment) String() string {
+	return string(s)
+}
+
+// Conference is one of the league's two conferences.
+type Conference string
+
+const (
+	ConferenceEast Conference = "East"
+	ConferenceWest Conference = "West"
+)
+
+func (c Conference) String() string {
+	return string(c)
+}
+
+// Division is one of the league's six divisions.
+type Division string
+
+const (
+	DivisionAtlantic  Division = "Atlantic"
+	DivisionCentral   Division = "Central"
+	DivisionSoutheast Division = "Southeast"
+	DivisionNorthwest Division = "Northwest"
+	DivisionPacific   Division = "Pacific"
+	DivisionSouthwest Division = "Southwest"
+)
+
+func (d Division) String() string {
+	return string(d)
+}
+
+// StatCategory is a stat the league's leaders are ranked by.
+type StatCategory string
+
+const (
+	CategoryPoints               StatCategory = "PTS"
+	CategoryRebounds             StatCategory = "REB"
+	CategoryAssists              StatCategory = "AST"
+	CategorySteals               StatCategory = "STL"
+	CategoryBlocks               StatCategory = "BLK"
+	CategoryFieldGoalPercentage  StatCategory = "FG_PCT"
+	CategoryThreePointPercentage StatCategory = "FG3_PCT"
+	CategoryFreeThrowPercentage  StatCategory = "FT_PCT"
+	CategoryEfficiency           StatCategory = "EFF"
+)
+
+func (c StatCategory) String() string {
+	return string(c)
+}
+
+// AggregateStats contains a stat line aggregated over many games. Depending
+// on the PerMode it was retrieved with, the stats are either totals or
+// averages, so they're not necessarily whole numbers.
+type AggregateStats struct {
+	GamesPlayed            int     `json:"games_played"`
+	GamesStarted           int     `json:"games_started"`
+	Minutes                float64 `json:"minutes"`
+	FieldGoalsMade         float64 `json:"field_goals_made"`
+	FieldGoalsAttempted    float64 `json:"field_goals_attempted"`
+	FieldGoalPercentage    float64 `json:"field_goal_percentage"`
+	ThreePointersMade      float64 `json:"three_pointers_made"`
+	ThreePointersAttempted float64 `json:"three_pointers_attempted"`
+	ThreePointPercentage   float64 `json:"three_point_percentage"`
+	FreeThrowsMade         float64 `json:"free_throws_made"`
+	FreeThrowsAttempted    float64 `json:"free_throws_attempted"`
+	FreeThrowPercentage    float64 `json:"free_throw_percentage"`
+	OffensiveRebounds      float64 `json:"offensive_rebounds"`
+	DefensiveRebounds      float64 `json:"defensive_rebounds"`
+	Rebounds               float64 `json:"rebounds"`
+	Assists                float64 `json:"assists"`
+	Steals                 float64 `json:"steals"`
+	Blocks                 float64 `json:"blocks"`
+	Turnovers              float64 `json:"turnovers"`
+	PersonalFouls          float64 `json:"personal_fouls"`
+	Points                 float64 `json:"points"`
+}
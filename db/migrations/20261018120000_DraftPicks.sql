@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE `players` ADD COLUMN `draft_year` INT NOT NULL DEFAULT 0 AFTER dleague;
+ALTER TABLE `players` ADD COLUMN `draft_round` INT NOT NULL DEFAULT 0 AFTER draft_year;
+ALTER TABLE `players` ADD COLUMN `draft_number` INT NOT NULL DEFAULT 0 AFTER draft_round;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE `players` DROP COLUMN `draft_year`;
+ALTER TABLE `players` DROP COLUMN `draft_round`;
+ALTER TABLE `players` DROP COLUMN `draft_number`;
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -40,12 +41,12 @@ func PrintErrors() ContinuousOption {
 	}
 }
 
-// Continuously will continuously sync database data. Typically,
-// it's invoked via a new goroutine:
+// Continuously will continuously sync database data until ctx is
+// cancelled. Typically, it's invoked via a new goroutine:
 //
-//     go sync.Continuously(s)
+//     go sync.Continuously(ctx, s)
 //
-func Continuously(s *Syncer, opts ...ContinuousOption) {
+func Continuously(ctx context.Context, s *Syncer, opts ...ContinuousOption) {
 	var c continuousSyncConfig
 	c = defaultContinuousConfig
 
@@ -53,22 +54,30 @@ func Continuously(s *Syncer, opts ...ContinuousOption) {
 		opt(&c)
 	}
 
-	allC := time.Tick(c.allGamesPeriod)
-	newC := time.Tick(c.newGamesPeriod)
-	liveC := time.Tick(c.liveGamesPeriod)
-	scheduledC := time.Tick(c.scheduledGamesPeriod)
-	teamsC := time.Tick(c.teamsPeriod)
+	allT := time.NewTicker(c.allGamesPeriod)
+	defer allT.Stop()
+	newT := time.NewTicker(c.newGamesPeriod)
+	defer newT.Stop()
+	liveT := time.NewTicker(c.liveGamesPeriod)
+	defer liveT.Stop()
+	scheduledT := time.NewTicker(c.scheduledGamesPeriod)
+	defer scheduledT.Stop()
+	teamsT := time.NewTicker(c.teamsPeriod)
+	defer teamsT.Stop()
 
 	for {
 		select {
-		case <-allC:
-			_, err := s.SyncAllGames(data.CurrentSeason)
+		case <-ctx.Done():
+			return
+
+		case <-allT.C:
+			_, err := s.SyncAllGames(ctx, data.CurrentSeason)
 			if err != nil {
 				c.errorFn(err)
 				continue
 			}
 
-		case <-liveC:
+		case <-liveT.C:
 			var liveGames []data.GameID
 			const gamesWithStatusQ = `SELECT id FROM games WHERE status = ?`
 			err := s.db.DB.Select(&liveGames, gamesWithStatusQ, data.Live)
@@ -77,13 +86,13 @@ func Continuously(s *Syncer, opts ...ContinuousOption) {
 				continue
 			}
 
-			_, err = s.SyncGamesWithIDs(data.CurrentSeason, liveGames)
+			_, err = s.SyncGamesWithIDs(ctx, data.CurrentSeason, liveGames)
 			if err != nil {
 				c.errorFn(err)
 				continue
 			}
 
-		case <-scheduledC:
+		case <-scheduledT.C:
 			var scheduledGames []data.GameID
 			const gamesWithStatusQ = `SELECT id FROM games WHERE status = ?`
 			err := s.db.DB.Select(&scheduledGames, gamesWithStatusQ, data.Scheduled)
@@ -92,14 +101,14 @@ func Continuously(s *Syncer, opts ...ContinuousOption) {
 				continue
 			}
 
-			_, err = s.SyncGamesWithIDs(data.CurrentSeason, scheduledGames)
+			_, err = s.SyncGamesWithIDs(ctx, data.CurrentSeason, scheduledGames)
 			if err != nil {
 				c.errorFn(err)
 				continue
 			}
 
-		case t := <-newC:
-			games, err := s.Client().GamesByDate(t)
+		case t := <-newT.C:
+			games, err := s.Client().GamesByDate(ctx, t)
 			if err != nil {
 				c.errorFn(err)
 				continue
@@ -110,14 +119,14 @@ func Continuously(s *Syncer, opts ...ContinuousOption) {
 				todaysGameIDs = append(todaysGameIDs, g.ID)
 			}
 
-			_, err = s.SyncGamesWithIDs(data.CurrentSeason, todaysGameIDs)
+			_, err = s.SyncGamesWithIDs(ctx, data.CurrentSeason, todaysGameIDs)
 			if err != nil {
 				c.errorFn(err)
 				continue
 			}
 
-		case <-teamsC:
-			_, err := s.SyncAllTeams()
+		case <-teamsT.C:
+			_, err := s.SyncAllTeams(ctx)
 			if err != nil {
 				c.errorFn(err)
 				continue
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
@@ -52,10 +53,16 @@ func (s *Syncer) Client() *nbagame.Client {
 	return nbagame.DefaultClient
 }
 
+// SetClient configures the syncer to retrieve data using the given Client
+// instead of the DefaultClient.
+func (s *Syncer) SetClient(c *nbagame.Client) {
+	s.api = c
+}
+
 // SyncAllTeams syncs all teams to the database. Running this after teams have already
 // been synced will update teams already in the database.
-func (s *Syncer) SyncAllTeams() (int, error) {
-	teams, err := s.Client().Teams()
+func (s *Syncer) SyncAllTeams(ctx context.Context) (int, error) {
+	teams, err := s.Client().Teams(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -71,50 +78,37 @@ func (s *Syncer) SyncAllTeams() (int, error) {
 }
 
 // SyncAllPlayers syncs all the players to the database. Running twice will update players.
-func (s *Syncer) SyncAllPlayers() (int, error) {
-	players, err := s.Client().HistoricalPlayers()
+func (s *Syncer) SyncAllPlayers(ctx context.Context) (int, error) {
+	players, err := s.Client().HistoricalPlayers(ctx)
 	if err != nil {
 		return 0, err
 	}
 
 	// Submit requests, but keeping the count under maximumConcurrentRequests
-	errs := make(chan error)
-
-	funcs := make([]func(), len(players))
-	for i, p := range players {
+	throttler := newThrottler(ctx, maximumConcurrentRequests)
+	for _, p := range players {
 		player := p
-		funcs[i] = func() {
-			playerDetails, err := s.Client().PlayerDetails(player.ID)
+		throttler.run(func() error {
+			playerDetails, err := s.Client().PlayerDetails(ctx, player.ID)
 			if err != nil {
 				s.logError(err)
-				errs <- err
-				return
+				return err
 			}
 
-			err = s.db.DB.Replace(playerDetails)
-			if err == nil {
-				s.log("processed %s", player)
+			if err := s.db.DB.Replace(playerDetails); err != nil {
+				s.logError(err)
+				return err
 			}
-			errs <- err
-		}
-	}
-	go throttle(funcs, maximumConcurrentRequests)
-
-	// Wait for all the goroutines to finish for every player. Record the last error
-	// to occur.
-	var retError error
-	for i := 0; i < len(players); i++ {
-		if err := <-errs; err != nil {
-			s.logError(err)
-			retError = err
-		}
+			s.log("processed %s", player)
+			return nil
+		})
 	}
-
-	return len(players), retError
+	// Report every player, even if some of them failed to sync.
+	return len(players), throttler.wait()
 }
 
-func (s *Syncer) allGameIDs(season data.Season) ([]data.GameID, error) {
-	teams, err := s.Client().Teams()
+func (s *Syncer) allGameIDs(ctx context.Context, season data.Season) ([]data.GameID, error) {
+	teams, err := s.Client().Teams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -123,11 +117,11 @@ func (s *Syncer) allGameIDs(season data.Season) ([]data.GameID, error) {
 	// to make 1 request per team to retrieve all of the games.
 	gameIDSet := make(map[data.GameID]struct{})
 	var mu sync.Mutex
-	throttler := newThrottler(maximumConcurrentRequests)
+	throttler := newThrottler(ctx, maximumConcurrentRequests)
 	for _, team := range teams {
 		t := team
 		throttler.run(func() error {
-			gameIDs, err := s.Client().GamesPlayedBy(season, t.ID)
+			gameIDs, err := s.Client().GamesPlayedBy(ctx, season, t.ID)
 			if err != nil {
 				return err
 			}
@@ -152,15 +146,15 @@ func (s *Syncer) allGameIDs(season data.Season) ([]data.GameID, error) {
 }
 
 // SyncGamesWithIDs syncs all games with the provided game IDs.
-func (s *Syncer) SyncGamesWithIDs(season data.Season, gameIDs []data.GameID) (int, error) {
+func (s *Syncer) SyncGamesWithIDs(ctx context.Context, season data.Season, gameIDs []data.GameID) (int, error) {
 	s.log("going to start syncing details for %v games", len(gameIDs))
 
 	// Now, we retrieve each individual game concurrently, and insert it into the database.
-	throttler := newThrottler(maximumConcurrentRequests)
+	throttler := newThrottler(ctx, maximumConcurrentRequests)
 	for _, gameID := range gameIDs {
 		id := gameID
 		throttler.run(func() error {
-			details, err := s.Client().GameDetails(string(id))
+			details, err := s.Client().GameDetails(ctx, string(id))
 			if err != nil {
 				s.log("err retrieving game details: %s", err)
 				return err
@@ -181,7 +175,7 @@ func (s *Syncer) SyncGamesWithIDs(season data.Season, gameIDs []data.GameID) (in
 			// Sync the box score too
 			if details.Status == data.Final {
 				// Box score is only available after the game :(
-				boxscore, err := s.Client().BoxScore(season, string(id))
+				boxscore, err := s.Client().BoxScore(ctx, season, string(id))
 				if err != nil {
 					s.log("err retrieving boxscore: %s", err)
 					return err
@@ -206,23 +200,20 @@ func (s *Syncer) SyncGamesWithIDs(season data.Season, gameIDs []data.GameID) (in
 			return nil
 		})
 	}
-	if err := throttler.wait(); err != nil {
-		return 0, err
-	}
-	return len(gameIDs), nil
+	return len(gameIDs), throttler.wait()
 }
 
 // SyncPlaysForGames syncs play-by-play histories for games
 // with the provided game IDs.
-func (s *Syncer) SyncPlaysForGames(season data.Season, gameIDs []data.GameID) (int, error) {
+func (s *Syncer) SyncPlaysForGames(ctx context.Context, season data.Season, gameIDs []data.GameID) (int, error) {
 	s.log("going to start syncing play-by-play for %v games", len(gameIDs))
 
 	// Now, we retrieve each individual game concurrently, and insert it into the database.
-	throttler := newThrottler(maximumConcurrentRequests)
+	throttler := newThrottler(ctx, maximumConcurrentRequests)
 	for _, gameID := range gameIDs {
 		id := gameID
 		throttler.run(func() error {
-			events, err := s.Client().GamePlayByPlay(season, string(id))
+			events, err := s.Client().GamePlayByPlay(ctx, season, string(id))
 			if err != nil {
 				s.log("err retrieving game play-by-play: %s", err)
 				return err
@@ -238,10 +229,7 @@ func (s *Syncer) SyncPlaysForGames(season data.Season, gameIDs []data.GameID) (i
 			return nil
 		})
 	}
-	if err := throttler.wait(); err != nil {
-		return 0, err
-	}
-	return len(gameIDs), nil
+	return len(gameIDs), throttler.wait()
 }
 
 // SyncAllGames syncs all the games for the given season to the database.
@@ -249,20 +237,22 @@ func (s *Syncer) SyncPlaysForGames(season data.Season, gameIDs []data.GameID) (i
 // this function does not optimize and try to predict which data may need
 // updating. It will re-fetch all games, including games that may have
 // happened several months ago.
-func (s *Syncer) SyncAllGames(season data.Season) (int, error) {
-	gameIDs, err := s.allGameIDs(season)
+func (s *Syncer) SyncAllGames(ctx context.Context, season data.Season) (int, error) {
+	gameIDs, err := s.allGameIDs(ctx, season)
 	if err != nil {
 		return 0, err
 	}
-	return s.SyncGamesWithIDs(season, gameIDs)
+	return s.SyncGamesWithIDs(ctx, season, gameIDs)
 }
 
-func (s *Syncer) SyncAllGamesPlayByPlay(season data.Season) (int, error) {
-	gameIDs, err := s.allGameIDs(season)
+// SyncAllGamesPlayByPlay syncs the play-by-play histories of all the games
+// in the given season.
+func (s *Syncer) SyncAllGamesPlayByPlay(ctx context.Context, season data.Season) (int, error) {
+	gameIDs, err := s.allGameIDs(ctx, season)
 	if err != nil {
 		return 0, err
 	}
-	return s.SyncPlaysForGames(season, gameIDs)
+	return s.SyncPlaysForGames(ctx, season, gameIDs)
 }
 
 func (s *Syncer) logError(err error) {
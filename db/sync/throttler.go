@@ -1,46 +1,45 @@
 package sync
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"sync"
 )
 
-func throttle(funcs []func(), maxConcurrent int) {
-	c := make(chan struct{}, maxConcurrent)
-	for _, f := range funcs {
-		// Wait until the throttle channel has buffer space
-		c <- struct{}{}
-		go func(f func()) {
-			// Execute the function
-			f()
-
-			// Let the next guy know that we just finished
-			<-c
-		}(f)
-	}
-}
+// maxErrorMessages is the most individual errors a multierror includes in
+// its message. Syncing thousands of players can fail thousands of times.
+const maxErrorMessages = 5
 
 type multierror []error
 
-// Error implements the error interface.
+// Error implements the error interface. Only the first maxErrorMessages
+// errors are included, followed by a count of the rest.
 func (e multierror) Error() string {
 	var errMessages []string
-	for _, err := range e {
+	for i, err := range e {
+		if i == maxErrorMessages {
+			errMessages = append(errMessages, fmt.Sprintf("and %v more", len(e)-i))
+			break
+		}
 		errMessages = append(errMessages, err.Error())
 	}
-	return strings.Join(errMessages, ", ")
+	return fmt.Sprintf("%v errors: %s", len(e), strings.Join(errMessages, ", "))
 }
 
 type throttler struct {
+	ctx       context.Context
 	wg        sync.WaitGroup
 	errors    []error
+	skipped   bool
 	pendingCh chan struct{}
 	errorsCh  chan error
 	doneCh    chan struct{}
 }
 
-func newThrottler(maxConcurrent int) *throttler {
+func newThrottler(ctx context.Context, maxConcurrent int) *throttler {
 	t := &throttler{
+		ctx:       ctx,
 		pendingCh: make(chan struct{}, maxConcurrent),
 		errorsCh:  make(chan error),
 		doneCh:    make(chan struct{}),
@@ -61,6 +60,12 @@ func (t *throttler) wait() error {
 	// Wait for the errors goroutine to exit.
 	<-t.doneCh
 
+	// If the context was cancelled before all of the work was started,
+	// report the cancellation rather than every individual failure.
+	if t.skipped {
+		return t.ctx.Err()
+	}
+
 	// Return a single aggregate error.
 	if len(t.errors) > 0 {
 		return multierror(t.errors)
@@ -75,9 +80,24 @@ func (t *throttler) loop() {
 	close(t.doneCh)
 }
 
+// run runs errorableFunc in a new goroutine once there's room under the
+// concurrency limit. If the throttler's context is cancelled first, the
+// function is never run.
 func (t *throttler) run(errorableFunc func() error) {
+	// Check for cancellation first; if there's also room under the limit,
+	// select would pick between the two at random.
+	if t.ctx.Err() != nil {
+		t.skipped = true
+		return
+	}
+	select {
+	case t.pendingCh <- struct{}{}:
+	case <-t.ctx.Done():
+		t.skipped = true
+		return
+	}
+
 	t.wg.Add(1)
-	t.pendingCh <- struct{}{}
 	go func() {
 		err := errorableFunc()
 		if err != nil {
@@ -0,0 +1,130 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// BoxScoreAdvancedParams defines parameters for a BoxScoreAdvanced request.
+// They're the same as those of a BoxScoreTraditional request.
+// http://stats.nba.com/stats/boxscoreadvancedv2?EndPeriod=10&EndRange=28800&GameID=0021401185&RangeType=2&Season=2014-15&SeasonType=Regular+Season&StartPeriod=1&StartRange=0
+type BoxScoreAdvancedParams BoxScoreTraditionalParams
+
+// BoxScoreAdvancedResponse is the type for all result sets returned by the
+// 'boxscoreadvancedv2' resource.
+type BoxScoreAdvancedResponse struct {
+	PlayerStats []*PlayerAdvancedStatsRow `nbagame:"PlayerStats"`
+	TeamStats   []*TeamAdvancedStatsRow   `nbagame:"TeamStats"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (resp *BoxScoreAdvancedResponse) ToData() *data.AdvancedBoxScore {
+	box := &data.AdvancedBoxScore{}
+	for _, r := range resp.TeamStats {
+		box.TeamStats = append(box.TeamStats, r.ToTeamAdvancedStats())
+	}
+	for _, r := range resp.PlayerStats {
+		box.PlayerStats = append(box.PlayerStats, r.ToPlayerAdvancedStats())
+	}
+	return box
+}
+
+// MergeInto sets the advanced stats of the teams and players in box.
+func (resp *BoxScoreAdvancedResponse) MergeInto(box *data.FullBoxScore) {
+	for _, r := range resp.TeamStats {
+		stats := r.AdvancedStatLine.ToAdvancedStats()
+		box.AddTeam(r.TeamID, r.TeamName, r.TeamAbbreviation, r.TeamCity).Advanced = &stats
+	}
+	for _, r := range resp.PlayerStats {
+		stats := r.AdvancedStatLine.ToAdvancedStats()
+		box.AddPlayer(r.PlayerID, r.PlayerName, r.TeamID).Advanced = &stats
+	}
+}
+
+// PlayerAdvancedStatsRow represents the schema returned for 'PlayerStats'
+// result sets, returned from the 'boxscoreadvancedv2' resource.
+type PlayerAdvancedStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	PlayerID         int    `nbagame:"PLAYER_ID"`
+	PlayerName       string `nbagame:"PLAYER_NAME"`
+	StartPosition    string `nbagame:"START_POSITION"`
+	Comment          string `nbagame:"COMMENT"`
+	AdvancedStatLine
+}
+
+// ToPlayerAdvancedStats converts this row into a PlayerAdvancedStats data
+// struct.
+func (r *PlayerAdvancedStatsRow) ToPlayerAdvancedStats() *data.PlayerAdvancedStats {
+	return &data.PlayerAdvancedStats{
+		PlayerID:      r.PlayerID,
+		PlayerName:    r.PlayerName,
+		TeamID:        r.TeamID,
+		AdvancedStats: r.AdvancedStatLine.ToAdvancedStats(),
+	}
+}
+
+// TeamAdvancedStatsRow represents the schema returned for 'TeamStats' result
+// sets, returned from the 'boxscoreadvancedv2' resource.
+type TeamAdvancedStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamName         string `nbagame:"TEAM_NAME"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	AdvancedStatLine
+}
+
+// ToTeamAdvancedStats converts this row into a TeamAdvancedStats data
+// struct.
+func (r *TeamAdvancedStatsRow) ToTeamAdvancedStats() *data.TeamAdvancedStats {
+	return &data.TeamAdvancedStats{
+		TeamID:           r.TeamID,
+		TeamName:         r.TeamName,
+		TeamAbbreviation: r.TeamAbbreviation,
+		TeamCity:         r.TeamCity,
+		AdvancedStats:    r.AdvancedStatLine.ToAdvancedStats(),
+	}
+}
+
+// AdvancedStatLine contains a summary of advanced statistics.
+type AdvancedStatLine struct {
+	SecondsPlayed                int     `nbagame:"MIN,seconds"`
+	OffensiveRating              float64 `nbagame:"OFF_RATING"`
+	DefensiveRating              float64 `nbagame:"DEF_RATING"`
+	NetRating                    float64 `nbagame:"NET_RATING"`
+	AssistPercentage             float64 `nbagame:"AST_PCT"`
+	AssistToTurnoverRatio        float64 `nbagame:"AST_TOV"`
+	AssistRatio                  float64 `nbagame:"AST_RATIO"`
+	OffensiveReboundPercentage   float64 `nbagame:"OREB_PCT"`
+	DefensiveReboundPercentage   float64 `nbagame:"DREB_PCT"`
+	ReboundPercentage            float64 `nbagame:"REB_PCT"`
+	TurnoverRatio                float64 `nbagame:"TM_TOV_PCT"`
+	EffectiveFieldGoalPercentage float64 `nbagame:"EFG_PCT"`
+	TrueShootingPercentage       float64 `nbagame:"TS_PCT"`
+	UsagePercentage              float64 `nbagame:"USG_PCT"`
+	Pace                         float64 `nbagame:"PACE"`
+	PIE                          float64 `nbagame:"PIE"`
+}
+
+// ToAdvancedStats converts an AdvancedStatLine into a data AdvancedStats
+// struct.
+func (sl *AdvancedStatLine) ToAdvancedStats() data.AdvancedStats {
+	return data.AdvancedStats{
+		SecondsPlayed:                sl.SecondsPlayed,
+		OffensiveRating:              sl.OffensiveRating,
+		DefensiveRating:              sl.DefensiveRating,
+		NetRating:                    sl.NetRating,
+		AssistPercentage:             sl.AssistPercentage,
+		AssistToTurnoverRatio:        sl.AssistToTurnoverRatio,
+		AssistRatio:                  sl.AssistRatio,
+		OffensiveReboundPercentage:   sl.OffensiveReboundPercentage,
+		DefensiveReboundPercentage:   sl.DefensiveReboundPercentage,
+		ReboundPercentage:            sl.ReboundPercentage,
+		TurnoverRatio:                sl.TurnoverRatio,
+		EffectiveFieldGoalPercentage: sl.EffectiveFieldGoalPercentage,
+		TrueShootingPercentage:       sl.TrueShootingPercentage,
+		UsagePercentage:              sl.UsagePercentage,
+		Pace:                         sl.Pace,
+		PIE:                          sl.PIE,
+	}
+}
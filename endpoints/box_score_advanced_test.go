@@ -0,0 +1,59 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBoxScoreAdvanced(t *testing.T) {
+	var resp BoxScoreAdvancedResponse
+	if err := testRequester.Request(context.Background(), "boxscoreadvancedv2", &BoxScoreAdvancedParams{
+		GameID:      "0021401185",
+		Season:      "2014-15",
+		SeasonType:  "Regular Season",
+		StartPeriod: 1,
+		EndPeriod:   10,
+		StartRange:  0,
+		EndRange:    28800,
+		RangeType:   2,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	box := resp.ToData()
+	if len(box.TeamStats) != 2 {
+		t.Fatalf("expected stats for 2 teams, got %v", len(box.TeamStats))
+	}
+	if len(box.PlayerStats) == 0 {
+		t.Fatal("expected player stats")
+	}
+
+	home, visitor := box.Team(1610612737), box.Team(1610612741)
+	if home == nil || visitor == nil {
+		t.Fatalf("expected stats for both teams, got %+v", box.TeamStats)
+	}
+	if home.OffensiveRating != visitor.DefensiveRating || home.DefensiveRating != visitor.OffensiveRating {
+		t.Errorf("expected opposite ratings, got %+v and %+v", home.AdvancedStats, visitor.AdvancedStats)
+	}
+	if home.NetRating <= 0 || home.NetRating != -visitor.NetRating {
+		t.Errorf("expected opposite net ratings, got %v and %v", home.NetRating, visitor.NetRating)
+	}
+	if home.Pace != visitor.Pace {
+		t.Errorf("expected the same pace, got %v and %v", home.Pace, visitor.Pace)
+	}
+
+	for _, team := range box.TeamStats {
+		if team.SecondsPlayed != 240*60 {
+			t.Errorf("expected %v to play 240 minutes, got %v seconds", team.TeamName, team.SecondsPlayed)
+		}
+	}
+
+	// Players who didn't play have no stats.
+	jenkins := box.Player(203098)
+	if jenkins == nil {
+		t.Fatal("expected a stat line for John Jenkins")
+	}
+	if jenkins.SecondsPlayed != 0 || jenkins.OffensiveRating != 0 || jenkins.PIE != 0 {
+		t.Errorf("expected an empty stat line, got %+v", jenkins.AdvancedStats)
+	}
+}
@@ -0,0 +1,80 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// BoxScoreFourFactorsParams defines parameters for a BoxScoreFourFactors request.
+// They're the same as those of a BoxScoreTraditional request.
+// http://stats.nba.com/stats/boxscorefourfactorsv2?EndPeriod=10&EndRange=28800&GameID=0021401185&RangeType=2&Season=2014-15&SeasonType=Regular+Season&StartPeriod=1&StartRange=0
+type BoxScoreFourFactorsParams BoxScoreTraditionalParams
+
+// BoxScoreFourFactorsResponse is the type for all result sets returned by the
+// 'boxscorefourfactorsv2' resource.
+type BoxScoreFourFactorsResponse struct {
+	PlayerStats []*PlayerFourFactorsStatsRow `nbagame:"PlayerStats"`
+	TeamStats   []*TeamFourFactorsStatsRow   `nbagame:"TeamStats"`
+}
+
+// MergeInto sets the four factors stats of the teams and players in box.
+func (resp *BoxScoreFourFactorsResponse) MergeInto(box *data.FullBoxScore) {
+	for _, r := range resp.TeamStats {
+		stats := r.FourFactorsStatLine.ToFourFactorsStats()
+		box.AddTeam(r.TeamID, r.TeamName, r.TeamAbbreviation, r.TeamCity).FourFactors = &stats
+	}
+	for _, r := range resp.PlayerStats {
+		stats := r.FourFactorsStatLine.ToFourFactorsStats()
+		box.AddPlayer(r.PlayerID, r.PlayerName, r.TeamID).FourFactors = &stats
+	}
+}
+
+// PlayerFourFactorsStatsRow represents the schema returned for 'PlayerStats'
+// result sets, returned from the 'boxscorefourfactorsv2' resource.
+type PlayerFourFactorsStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	PlayerID         int    `nbagame:"PLAYER_ID"`
+	PlayerName       string `nbagame:"PLAYER_NAME"`
+	StartPosition    string `nbagame:"START_POSITION"`
+	Comment          string `nbagame:"COMMENT"`
+	FourFactorsStatLine
+}
+
+// TeamFourFactorsStatsRow represents the schema returned for 'TeamStats' result
+// sets, returned from the 'boxscorefourfactorsv2' resource.
+type TeamFourFactorsStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamName         string `nbagame:"TEAM_NAME"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	FourFactorsStatLine
+}
+
+// FourFactorsStatLine contains a summary of four factors statistics.
+type FourFactorsStatLine struct {
+	SecondsPlayed                        int     `nbagame:"MIN,seconds"`
+	EffectiveFieldGoalPercentage         float64 `nbagame:"EFG_PCT"`
+	FreeThrowAttemptRate                 float64 `nbagame:"FTA_RATE"`
+	TurnoverRatio                        float64 `nbagame:"TM_TOV_PCT"`
+	OffensiveReboundPercentage           float64 `nbagame:"OREB_PCT"`
+	OpponentEffectiveFieldGoalPercentage float64 `nbagame:"OPP_EFG_PCT"`
+	OpponentFreeThrowAttemptRate         float64 `nbagame:"OPP_FTA_RATE"`
+	OpponentTurnoverRatio                float64 `nbagame:"OPP_TOV_PCT"`
+	OpponentOffensiveReboundPercentage   float64 `nbagame:"OPP_OREB_PCT"`
+}
+
+// ToFourFactorsStats converts a FourFactorsStatLine into a data FourFactorsStats struct.
+func (sl *FourFactorsStatLine) ToFourFactorsStats() data.FourFactorsStats {
+	return data.FourFactorsStats{
+		SecondsPlayed:                        sl.SecondsPlayed,
+		EffectiveFieldGoalPercentage:         sl.EffectiveFieldGoalPercentage,
+		FreeThrowAttemptRate:                 sl.FreeThrowAttemptRate,
+		TurnoverRatio:                        sl.TurnoverRatio,
+		OffensiveReboundPercentage:           sl.OffensiveReboundPercentage,
+		OpponentEffectiveFieldGoalPercentage: sl.OpponentEffectiveFieldGoalPercentage,
+		OpponentFreeThrowAttemptRate:         sl.OpponentFreeThrowAttemptRate,
+		OpponentTurnoverRatio:                sl.OpponentTurnoverRatio,
+		OpponentOffensiveReboundPercentage:   sl.OpponentOffensiveReboundPercentage,
+	}
+}
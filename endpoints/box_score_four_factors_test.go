@@ -0,0 +1,27 @@
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestBoxScoreFourFactors(t *testing.T) {
+	box := &data.FullBoxScore{}
+	requestBoxScore(t, "boxscorefourfactorsv2", &BoxScoreFourFactorsResponse{}, box)
+
+	home, visitor := box.Team(1610612737), box.Team(1610612741)
+	if home == nil || visitor == nil {
+		t.Fatalf("expected stats for both teams, got %+v", box.TeamStats)
+	}
+	if home.FourFactors.EffectiveFieldGoalPercentage != visitor.FourFactors.OpponentEffectiveFieldGoalPercentage ||
+		home.FourFactors.OpponentTurnoverRatio != visitor.FourFactors.TurnoverRatio {
+		t.Errorf("expected opposite factors, got %+v and %+v", home.FourFactors, visitor.FourFactors)
+	}
+	for _, player := range box.PlayerStats {
+		team := box.Team(player.TeamID)
+		if player.FourFactors.SecondsPlayed > 0 && player.FourFactors.OpponentFreeThrowAttemptRate != team.FourFactors.OpponentFreeThrowAttemptRate {
+			t.Errorf("expected %v's opponents to share %v's free throw rate, got %v", player.PlayerName, team.TeamName, player.FourFactors.OpponentFreeThrowAttemptRate)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// BoxScoreMiscParams defines parameters for a BoxScoreMisc request.
+// They're the same as those of a BoxScoreTraditional request.
+// http://stats.nba.com/stats/boxscoremiscv2?EndPeriod=10&EndRange=28800&GameID=0021401185&RangeType=2&Season=2014-15&SeasonType=Regular+Season&StartPeriod=1&StartRange=0
+type BoxScoreMiscParams BoxScoreTraditionalParams
+
+// BoxScoreMiscResponse is the type for all result sets returned by the
+// 'boxscoremiscv2' resource.
+type BoxScoreMiscResponse struct {
+	PlayerStats []*PlayerMiscStatsRow `nbagame:"PlayerStats"`
+	TeamStats   []*TeamMiscStatsRow   `nbagame:"TeamStats"`
+}
+
+// MergeInto sets the misc stats of the teams and players in box.
+func (resp *BoxScoreMiscResponse) MergeInto(box *data.FullBoxScore) {
+	for _, r := range resp.TeamStats {
+		stats := r.MiscStatLine.ToMiscStats()
+		box.AddTeam(r.TeamID, r.TeamName, r.TeamAbbreviation, r.TeamCity).Misc = &stats
+	}
+	for _, r := range resp.PlayerStats {
+		stats := r.MiscStatLine.ToMiscStats()
+		box.AddPlayer(r.PlayerID, r.PlayerName, r.TeamID).Misc = &stats
+	}
+}
+
+// PlayerMiscStatsRow represents the schema returned for 'PlayerStats'
+// result sets, returned from the 'boxscoremiscv2' resource.
+type PlayerMiscStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	PlayerID         int    `nbagame:"PLAYER_ID"`
+	PlayerName       string `nbagame:"PLAYER_NAME"`
+	StartPosition    string `nbagame:"START_POSITION"`
+	Comment          string `nbagame:"COMMENT"`
+	MiscStatLine
+}
+
+// TeamMiscStatsRow represents the schema returned for 'TeamStats' result
+// sets, returned from the 'boxscoremiscv2' resource.
+type TeamMiscStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamName         string `nbagame:"TEAM_NAME"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	MiscStatLine
+}
+
+// MiscStatLine contains a summary of misc statistics.
+type MiscStatLine struct {
+	SecondsPlayed              int `nbagame:"MIN,seconds"`
+	PointsOffTurnovers         int `nbagame:"PTS_OFF_TOV"`
+	SecondChancePoints         int `nbagame:"PTS_2ND_CHANCE"`
+	FastBreakPoints            int `nbagame:"PTS_FB"`
+	PointsInPaint              int `nbagame:"PTS_PAINT"`
+	OpponentPointsOffTurnovers int `nbagame:"OPP_PTS_OFF_TOV"`
+	OpponentSecondChancePoints int `nbagame:"OPP_PTS_2ND_CHANCE"`
+	OpponentFastBreakPoints    int `nbagame:"OPP_PTS_FB"`
+	OpponentPointsInPaint      int `nbagame:"OPP_PTS_PAINT"`
+	Blocks                     int `nbagame:"BLK"`
+	BlocksAgainst              int `nbagame:"BLKA"`
+	PersonalFouls              int `nbagame:"PF"`
+	PersonalFoulsDrawn         int `nbagame:"PFD"`
+}
+
+// ToMiscStats converts a MiscStatLine into a data MiscStats struct.
+func (sl *MiscStatLine) ToMiscStats() data.MiscStats {
+	return data.MiscStats{
+		SecondsPlayed:              sl.SecondsPlayed,
+		PointsOffTurnovers:         sl.PointsOffTurnovers,
+		SecondChancePoints:         sl.SecondChancePoints,
+		FastBreakPoints:            sl.FastBreakPoints,
+		PointsInPaint:              sl.PointsInPaint,
+		OpponentPointsOffTurnovers: sl.OpponentPointsOffTurnovers,
+		OpponentSecondChancePoints: sl.OpponentSecondChancePoints,
+		OpponentFastBreakPoints:    sl.OpponentFastBreakPoints,
+		OpponentPointsInPaint:      sl.OpponentPointsInPaint,
+		Blocks:                     sl.Blocks,
+		BlocksAgainst:              sl.BlocksAgainst,
+		PersonalFouls:              sl.PersonalFouls,
+		PersonalFoulsDrawn:         sl.PersonalFoulsDrawn,
+	}
+}
@@ -0,0 +1,36 @@
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestBoxScoreMisc(t *testing.T) {
+	box := &data.FullBoxScore{}
+	requestBoxScore(t, "boxscoremiscv2", &BoxScoreMiscResponse{}, box)
+
+	if len(box.TeamStats) != 2 {
+		t.Fatalf("expected stats for 2 teams, got %v", len(box.TeamStats))
+	}
+	for _, team := range box.TeamStats {
+		var paint, blocksAgainst int
+		for _, player := range box.PlayerStats {
+			if player.TeamID == team.TeamID {
+				paint += player.Misc.PointsInPaint
+				blocksAgainst += player.Misc.BlocksAgainst
+			}
+		}
+		if paint != team.Misc.PointsInPaint {
+			t.Errorf("expected %v players to score %v points in the paint, got %v", team.TeamName, team.Misc.PointsInPaint, paint)
+		}
+		if blocksAgainst != team.Misc.BlocksAgainst {
+			t.Errorf("expected %v players to be blocked %v times, got %v", team.TeamName, team.Misc.BlocksAgainst, blocksAgainst)
+		}
+	}
+
+	home, visitor := box.Team(1610612737), box.Team(1610612741)
+	if home.Misc.OpponentFastBreakPoints != visitor.Misc.FastBreakPoints || home.Misc.PersonalFoulsDrawn != visitor.Misc.PersonalFouls {
+		t.Errorf("expected opposite stats, got %+v and %+v", home.Misc, visitor.Misc)
+	}
+}
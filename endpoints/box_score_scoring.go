@@ -0,0 +1,94 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// BoxScoreScoringParams defines parameters for a BoxScoreScoring request.
+// They're the same as those of a BoxScoreTraditional request.
+// http://stats.nba.com/stats/boxscorescoringv2?EndPeriod=10&EndRange=28800&GameID=0021401185&RangeType=2&Season=2014-15&SeasonType=Regular+Season&StartPeriod=1&StartRange=0
+type BoxScoreScoringParams BoxScoreTraditionalParams
+
+// BoxScoreScoringResponse is the type for all result sets returned by the
+// 'boxscorescoringv2' resource.
+type BoxScoreScoringResponse struct {
+	PlayerStats []*PlayerScoringStatsRow `nbagame:"PlayerStats"`
+	TeamStats   []*TeamScoringStatsRow   `nbagame:"TeamStats"`
+}
+
+// MergeInto sets the scoring stats of the teams and players in box.
+func (resp *BoxScoreScoringResponse) MergeInto(box *data.FullBoxScore) {
+	for _, r := range resp.TeamStats {
+		stats := r.ScoringStatLine.ToScoringStats()
+		box.AddTeam(r.TeamID, r.TeamName, r.TeamAbbreviation, r.TeamCity).Scoring = &stats
+	}
+	for _, r := range resp.PlayerStats {
+		stats := r.ScoringStatLine.ToScoringStats()
+		box.AddPlayer(r.PlayerID, r.PlayerName, r.TeamID).Scoring = &stats
+	}
+}
+
+// PlayerScoringStatsRow represents the schema returned for 'PlayerStats'
+// result sets, returned from the 'boxscorescoringv2' resource.
+type PlayerScoringStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	PlayerID         int    `nbagame:"PLAYER_ID"`
+	PlayerName       string `nbagame:"PLAYER_NAME"`
+	StartPosition    string `nbagame:"START_POSITION"`
+	Comment          string `nbagame:"COMMENT"`
+	ScoringStatLine
+}
+
+// TeamScoringStatsRow represents the schema returned for 'TeamStats' result
+// sets, returned from the 'boxscorescoringv2' resource.
+type TeamScoringStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamName         string `nbagame:"TEAM_NAME"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	ScoringStatLine
+}
+
+// ScoringStatLine contains a summary of scoring statistics.
+type ScoringStatLine struct {
+	SecondsPlayed                      int     `nbagame:"MIN,seconds"`
+	PercentFieldGoalsAttemptedTwo      float64 `nbagame:"PCT_FGA_2PT"`
+	PercentFieldGoalsAttemptedThree    float64 `nbagame:"PCT_FGA_3PT"`
+	PercentPointsTwo                   float64 `nbagame:"PCT_PTS_2PT"`
+	PercentPointsMidRange              float64 `nbagame:"PCT_PTS_2PT_MR"`
+	PercentPointsThree                 float64 `nbagame:"PCT_PTS_3PT"`
+	PercentPointsFastBreak             float64 `nbagame:"PCT_PTS_FB"`
+	PercentPointsFreeThrow             float64 `nbagame:"PCT_PTS_FT"`
+	PercentPointsOffTurnovers          float64 `nbagame:"PCT_PTS_OFF_TOV"`
+	PercentPointsInPaint               float64 `nbagame:"PCT_PTS_PAINT"`
+	PercentAssistedTwoPointersMade     float64 `nbagame:"PCT_AST_2PM"`
+	PercentUnassistedTwoPointersMade   float64 `nbagame:"PCT_UAST_2PM"`
+	PercentAssistedThreePointersMade   float64 `nbagame:"PCT_AST_3PM"`
+	PercentUnassistedThreePointersMade float64 `nbagame:"PCT_UAST_3PM"`
+	PercentAssistedFieldGoalsMade      float64 `nbagame:"PCT_AST_FGM"`
+	PercentUnassistedFieldGoalsMade    float64 `nbagame:"PCT_UAST_FGM"`
+}
+
+// ToScoringStats converts a ScoringStatLine into a data ScoringStats struct.
+func (sl *ScoringStatLine) ToScoringStats() data.ScoringStats {
+	return data.ScoringStats{
+		SecondsPlayed:                      sl.SecondsPlayed,
+		PercentFieldGoalsAttemptedTwo:      sl.PercentFieldGoalsAttemptedTwo,
+		PercentFieldGoalsAttemptedThree:    sl.PercentFieldGoalsAttemptedThree,
+		PercentPointsTwo:                   sl.PercentPointsTwo,
+		PercentPointsMidRange:              sl.PercentPointsMidRange,
+		PercentPointsThree:                 sl.PercentPointsThree,
+		PercentPointsFastBreak:             sl.PercentPointsFastBreak,
+		PercentPointsFreeThrow:             sl.PercentPointsFreeThrow,
+		PercentPointsOffTurnovers:          sl.PercentPointsOffTurnovers,
+		PercentPointsInPaint:               sl.PercentPointsInPaint,
+		PercentAssistedTwoPointersMade:     sl.PercentAssistedTwoPointersMade,
+		PercentUnassistedTwoPointersMade:   sl.PercentUnassistedTwoPointersMade,
+		PercentAssistedThreePointersMade:   sl.PercentAssistedThreePointersMade,
+		PercentUnassistedThreePointersMade: sl.PercentUnassistedThreePointersMade,
+		PercentAssistedFieldGoalsMade:      sl.PercentAssistedFieldGoalsMade,
+		PercentUnassistedFieldGoalsMade:    sl.PercentUnassistedFieldGoalsMade,
+	}
+}
@@ -0,0 +1,26 @@
+package endpoints
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestBoxScoreScoring(t *testing.T) {
+	box := &data.FullBoxScore{}
+	requestBoxScore(t, "boxscorescoringv2", &BoxScoreScoringResponse{}, box)
+
+	if len(box.TeamStats) != 2 {
+		t.Fatalf("expected stats for 2 teams, got %v", len(box.TeamStats))
+	}
+	for _, team := range box.TeamStats {
+		s := team.Scoring
+		if total := s.PercentPointsTwo + s.PercentPointsThree + s.PercentPointsFreeThrow; math.Abs(total-1) > 0.01 {
+			t.Errorf("expected %v's points to add up, got %v", team.TeamName, total)
+		}
+		if total := s.PercentAssistedFieldGoalsMade + s.PercentUnassistedFieldGoalsMade; math.Abs(total-1) > 0.01 {
+			t.Errorf("expected %v's field goals to add up, got %v", team.TeamName, total)
+		}
+	}
+}
@@ -48,7 +48,9 @@ type BoxScoreSummaryResponse struct {
 
 func (r *BoxScoreSummaryResponse) ToData() (*data.GameDetails, error) {
 	if len(r.GameSummary) < 1 {
-		return nil, ErrBadResponse("no game summary data")
+		// stats.nba.com responds to requests for unknown games with empty
+		// result sets.
+		return nil, fmt.Errorf("%w: no game summary data", ErrNotFound)
 	} else if len(r.LastMeeting) < 1 {
 		return nil, ErrBadResponse("no last meeting data")
 	} else if len(r.GameInfo) < 1 {
@@ -1,6 +1,11 @@
 package endpoints
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
 
 func TestBoxScoreSummary(t *testing.T) {
 	params := BoxScoreSummaryParams{
@@ -8,10 +13,42 @@ func TestBoxScoreSummary(t *testing.T) {
 	}
 
 	var resp BoxScoreSummaryResponse
-	if err := DefaultRequester.Request("boxscoresummaryv2", params, &resp); err != nil {
+	if err := testRequester.Request(context.Background(), "boxscoresummaryv2", params, &resp); err != nil {
 		t.Fatal(err)
 	}
 	if len(resp.GameSummary) == 0 {
 		t.Error("Empty response for boxscoresummary request.")
 	}
+
+	details, err := resp.ToData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.ID != "0021401185" || details.Season != "2014-15" || details.Playoffs {
+		t.Errorf("unexpected game: %+v", details.Game)
+	}
+	if details.HomeTeamID != 1610612737 || details.VisitorTeamID != 1610612741 {
+		t.Errorf("expected the Bulls at the Hawks, got %+v", details.Game)
+	}
+	if details.Status != data.Final {
+		t.Errorf("expected a final game, got status %v", details.Status)
+	}
+	if details.LengthMinutes != 138 {
+		t.Errorf("expected a 2:18 game to last 138 minutes, got %v", details.LengthMinutes)
+	}
+	if len(details.Officials) != 3 {
+		t.Errorf("expected 3 officials, got %v", len(details.Officials))
+	}
+	for _, official := range details.Officials {
+		if official.JerseyNumber == "" || official.JerseyNumber[len(official.JerseyNumber)-1] == ' ' {
+			t.Errorf("expected a trimmed jersey number, got %q", official.JerseyNumber)
+		}
+	}
+
+	home, visitor := details.HomePoints, details.VisitorPoints
+	homeTotal := home.FirstQuarter + home.SecondQuarter + home.ThirdQuarter + home.FourthQuarter
+	visitorTotal := visitor.FirstQuarter + visitor.SecondQuarter + visitor.ThirdQuarter + visitor.FourthQuarter
+	if homeTotal != 95 || visitorTotal != 88 {
+		t.Errorf("expected a 95-88 final, got %v-%v", homeTotal, visitorTotal)
+	}
 }
@@ -38,6 +38,22 @@ func (resp *BoxScoreTraditionalResponse) ToData() ([]*data.TeamStats, []*data.Pl
 	return teamStats, playerStats
 }
 
+// MergeInto sets the traditional stats of the teams and players in box.
+func (resp *BoxScoreTraditionalResponse) MergeInto(box *data.FullBoxScore) {
+	for _, r := range resp.TeamStats {
+		box.AddTeam(r.TeamID, r.TeamName, r.TeamAbbreviation, r.TeamCity).Traditional = r.StatLine.ToStats()
+	}
+	for _, r := range resp.PlayerStats {
+		box.AddPlayer(r.PlayerID, r.PlayerName, r.TeamID).Traditional = r.StatLine.ToStats()
+	}
+}
+
+// BoxScoreMerger is implemented by the responses of each box score variant,
+// which can be merged into a full box score.
+type BoxScoreMerger interface {
+	MergeInto(box *data.FullBoxScore)
+}
+
 // PlayerStatsRow represents the schema returned for 'PlayerStats' result
 // sets, returned from the 'boxscore' resource.
 type PlayerStatsRow struct {
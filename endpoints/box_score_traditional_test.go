@@ -0,0 +1,111 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestBoxScoreTraditional(t *testing.T) {
+	var resp BoxScoreTraditionalResponse
+	if err := testRequester.Request(context.Background(), "boxscoretraditionalv2", &BoxScoreTraditionalParams{
+		GameID:      "0021401185",
+		Season:      "2014-15",
+		SeasonType:  "Regular Season",
+		StartPeriod: 1,
+		EndPeriod:   10,
+		StartRange:  0,
+		EndRange:    28800,
+		RangeType:   2,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	teamStats, playerStats := resp.ToData()
+	if len(teamStats) != 2 {
+		t.Fatalf("expected stats for 2 teams, got %v", len(teamStats))
+	}
+	if len(playerStats) == 0 {
+		t.Fatal("expected player stats")
+	}
+
+	for _, team := range teamStats {
+		if team.SecondsPlayed != 240*60 {
+			t.Errorf("expected %v to play 240 minutes, got %v seconds", team.TeamName, team.SecondsPlayed)
+		}
+
+		var points, seconds int
+		for _, player := range playerStats {
+			if player.TeamID == team.TeamID {
+				points += player.Points
+				seconds += player.SecondsPlayed
+			}
+		}
+		if points != team.Points {
+			t.Errorf("expected %v players to score %v points, got %v", team.TeamName, team.Points, points)
+		}
+		if seconds != team.SecondsPlayed {
+			t.Errorf("expected %v players to play %v seconds, got %v", team.TeamName, team.SecondsPlayed, seconds)
+		}
+	}
+	if teamStats[0].PlusMinus != -teamStats[1].PlusMinus {
+		t.Errorf("expected opposite plus-minus, got %v and %v", teamStats[0].PlusMinus, teamStats[1].PlusMinus)
+	}
+}
+
+// requestBoxScore requests the recorded box score variant from endpoint, and
+// merges it into a full box score.
+func requestBoxScore(t *testing.T, endpoint string, resp BoxScoreMerger, box *data.FullBoxScore) {
+	t.Helper()
+	if err := testRequester.Request(context.Background(), endpoint, &BoxScoreTraditionalParams{
+		GameID:      "0021401185",
+		Season:      "2014-15",
+		SeasonType:  "Regular Season",
+		StartPeriod: 1,
+		EndPeriod:   10,
+		StartRange:  0,
+		EndRange:    28800,
+		RangeType:   2,
+	}, resp); err != nil {
+		t.Fatal(err)
+	}
+	resp.MergeInto(box)
+}
+
+func TestMergeFullBoxScore(t *testing.T) {
+	box := &data.FullBoxScore{}
+	requestBoxScore(t, "boxscoretraditionalv2", &BoxScoreTraditionalResponse{}, box)
+	requestBoxScore(t, "boxscoreadvancedv2", &BoxScoreAdvancedResponse{}, box)
+	requestBoxScore(t, "boxscorefourfactorsv2", &BoxScoreFourFactorsResponse{}, box)
+	requestBoxScore(t, "boxscoremiscv2", &BoxScoreMiscResponse{}, box)
+	requestBoxScore(t, "boxscorescoringv2", &BoxScoreScoringResponse{}, box)
+	requestBoxScore(t, "boxscoreusagev2", &BoxScoreUsageResponse{}, box)
+
+	if len(box.TeamStats) != 2 {
+		t.Fatalf("expected stats for 2 teams, got %v", len(box.TeamStats))
+	}
+	var traditional BoxScoreTraditionalResponse
+	requestBoxScore(t, "boxscoretraditionalv2", &traditional, &data.FullBoxScore{})
+	if len(box.PlayerStats) != len(traditional.PlayerStats) {
+		t.Fatalf("expected stats for %v players, got %v", len(traditional.PlayerStats), len(box.PlayerStats))
+	}
+
+	for _, team := range box.TeamStats {
+		if team.Traditional == nil || team.Advanced == nil || team.FourFactors == nil || team.Misc == nil || team.Scoring == nil || team.Usage == nil {
+			t.Errorf("expected every variant for %v, got %+v", team.TeamName, team.FullStats)
+		}
+	}
+	for _, player := range box.PlayerStats {
+		if player.Traditional == nil || player.Advanced == nil || player.FourFactors == nil || player.Misc == nil || player.Scoring == nil || player.Usage == nil {
+			t.Errorf("expected every variant for %v, got %+v", player.PlayerName, player.FullStats)
+			continue
+		}
+		if player.Traditional.SecondsPlayed != player.Usage.SecondsPlayed {
+			t.Errorf("expected %v to play %v seconds in every variant, got %v", player.PlayerName, player.Traditional.SecondsPlayed, player.Usage.SecondsPlayed)
+		}
+		if player.Traditional.Blocks != player.Misc.Blocks {
+			t.Errorf("expected %v blocks for %v, got %v", player.Traditional.Blocks, player.PlayerName, player.Misc.Blocks)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// BoxScoreUsageParams defines parameters for a BoxScoreUsage request.
+// They're the same as those of a BoxScoreTraditional request.
+// http://stats.nba.com/stats/boxscoreusagev2?EndPeriod=10&EndRange=28800&GameID=0021401185&RangeType=2&Season=2014-15&SeasonType=Regular+Season&StartPeriod=1&StartRange=0
+type BoxScoreUsageParams BoxScoreTraditionalParams
+
+// BoxScoreUsageResponse is the type for all result sets returned by the
+// 'boxscoreusagev2' resource.
+type BoxScoreUsageResponse struct {
+	PlayerStats []*PlayerUsageStatsRow `nbagame:"PlayerStats"`
+	TeamStats   []*TeamUsageStatsRow   `nbagame:"TeamStats"`
+}
+
+// MergeInto sets the usage stats of the teams and players in box.
+func (resp *BoxScoreUsageResponse) MergeInto(box *data.FullBoxScore) {
+	for _, r := range resp.TeamStats {
+		stats := r.UsageStatLine.ToUsageStats()
+		box.AddTeam(r.TeamID, r.TeamName, r.TeamAbbreviation, r.TeamCity).Usage = &stats
+	}
+	for _, r := range resp.PlayerStats {
+		stats := r.UsageStatLine.ToUsageStats()
+		box.AddPlayer(r.PlayerID, r.PlayerName, r.TeamID).Usage = &stats
+	}
+}
+
+// PlayerUsageStatsRow represents the schema returned for 'PlayerStats'
+// result sets, returned from the 'boxscoreusagev2' resource.
+type PlayerUsageStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	PlayerID         int    `nbagame:"PLAYER_ID"`
+	PlayerName       string `nbagame:"PLAYER_NAME"`
+	StartPosition    string `nbagame:"START_POSITION"`
+	Comment          string `nbagame:"COMMENT"`
+	UsageStatLine
+}
+
+// TeamUsageStatsRow represents the schema returned for 'TeamStats' result
+// sets, returned from the 'boxscoreusagev2' resource.
+type TeamUsageStatsRow struct {
+	GameID           string `nbagame:"GAME_ID"`
+	TeamID           int    `nbagame:"TEAM_ID"`
+	TeamName         string `nbagame:"TEAM_NAME"`
+	TeamAbbreviation string `nbagame:"TEAM_ABBREVIATION"`
+	TeamCity         string `nbagame:"TEAM_CITY"`
+	UsageStatLine
+}
+
+// UsageStatLine contains a summary of usage statistics.
+type UsageStatLine struct {
+	SecondsPlayed                 int     `nbagame:"MIN,seconds"`
+	UsagePercentage               float64 `nbagame:"USG_PCT"`
+	PercentFieldGoalsMade         float64 `nbagame:"PCT_FGM"`
+	PercentFieldGoalsAttempted    float64 `nbagame:"PCT_FGA"`
+	PercentThreePointersMade      float64 `nbagame:"PCT_FG3M"`
+	PercentThreePointersAttempted float64 `nbagame:"PCT_FG3A"`
+	PercentFreeThrowsMade         float64 `nbagame:"PCT_FTM"`
+	PercentFreeThrowsAttempted    float64 `nbagame:"PCT_FTA"`
+	PercentOffensiveRebounds      float64 `nbagame:"PCT_OREB"`
+	PercentDefensiveRebounds      float64 `nbagame:"PCT_DREB"`
+	PercentRebounds               float64 `nbagame:"PCT_REB"`
+	PercentAssists                float64 `nbagame:"PCT_AST"`
+	PercentTurnovers              float64 `nbagame:"PCT_TOV"`
+	PercentSteals                 float64 `nbagame:"PCT_STL"`
+	PercentBlocks                 float64 `nbagame:"PCT_BLK"`
+	PercentBlocksAgainst          float64 `nbagame:"PCT_BLKA"`
+	PercentPersonalFouls          float64 `nbagame:"PCT_PF"`
+	PercentPersonalFoulsDrawn     float64 `nbagame:"PCT_PFD"`
+	PercentPoints                 float64 `nbagame:"PCT_PTS"`
+}
+
+// ToUsageStats converts a UsageStatLine into a data UsageStats struct.
+func (sl *UsageStatLine) ToUsageStats() data.UsageStats {
+	return data.UsageStats{
+		SecondsPlayed:                 sl.SecondsPlayed,
+		UsagePercentage:               sl.UsagePercentage,
+		PercentFieldGoalsMade:         sl.PercentFieldGoalsMade,
+		PercentFieldGoalsAttempted:    sl.PercentFieldGoalsAttempted,
+		PercentThreePointersMade:      sl.PercentThreePointersMade,
+		PercentThreePointersAttempted: sl.PercentThreePointersAttempted,
+		PercentFreeThrowsMade:         sl.PercentFreeThrowsMade,
+		PercentFreeThrowsAttempted:    sl.PercentFreeThrowsAttempted,
+		PercentOffensiveRebounds:      sl.PercentOffensiveRebounds,
+		PercentDefensiveRebounds:      sl.PercentDefensiveRebounds,
+		PercentRebounds:               sl.PercentRebounds,
+		PercentAssists:                sl.PercentAssists,
+		PercentTurnovers:              sl.PercentTurnovers,
+		PercentSteals:                 sl.PercentSteals,
+		PercentBlocks:                 sl.PercentBlocks,
+		PercentBlocksAgainst:          sl.PercentBlocksAgainst,
+		PercentPersonalFouls:          sl.PercentPersonalFouls,
+		PercentPersonalFoulsDrawn:     sl.PercentPersonalFoulsDrawn,
+		PercentPoints:                 sl.PercentPoints,
+	}
+}
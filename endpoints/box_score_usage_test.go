@@ -0,0 +1,32 @@
+package endpoints
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestBoxScoreUsage(t *testing.T) {
+	box := &data.FullBoxScore{}
+	requestBoxScore(t, "boxscoreusagev2", &BoxScoreUsageResponse{}, box)
+
+	if len(box.TeamStats) != 2 {
+		t.Fatalf("expected stats for 2 teams, got %v", len(box.TeamStats))
+	}
+	for _, team := range box.TeamStats {
+		if team.Usage.UsagePercentage != 1 || team.Usage.PercentPoints != 1 {
+			t.Errorf("expected %v to use all of its possessions, got %+v", team.TeamName, team.Usage)
+		}
+
+		var points float64
+		for _, player := range box.PlayerStats {
+			if player.TeamID == team.TeamID {
+				points += player.Usage.PercentPoints
+			}
+		}
+		if math.Abs(points-1) > 0.01 {
+			t.Errorf("expected %v players' share of points to add up, got %v", team.TeamName, points)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package endpoints
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// Forever is a cache TTL for responses that never expire.
+const Forever time.Duration = math.MaxInt64
+
+// DefaultCachePolicy is the CachePolicy used by Requesters that have a Cache
+// but no CachePolicy.
+var DefaultCachePolicy = NewCachePolicy()
+
+// Cache stores raw endpoint response bodies. Implementations must be safe
+// for concurrent use.
+type Cache interface {
+	// Get returns the body stored for the key, if one exists and hasn't
+	// expired.
+	Get(key string) ([]byte, bool)
+	// Set stores the body for the key for the ttl, which may be Forever.
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// cacheKey returns the key a response is cached under.
+func cacheKey(endpoint string, params url.Values) string {
+	return endpoint + "?" + params.Encode()
+}
+
+// expiry returns when an entry stored now with the given ttl expires. The
+// zero time means the entry never expires.
+func expiry(now time.Time, ttl time.Duration) time.Time {
+	if ttl == Forever {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+func expired(expires time.Time, now time.Time) bool {
+	return !expires.IsZero() && now.After(expires)
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entries once it holds its maximum number of entries.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // most recently used at the front
+}
+
+type memoryCacheEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+// NewMemoryCache constructs a MemoryCache holding at most capacity
+// responses.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*memoryCacheEntry)
+	if expired(entry.expires, time.Now()) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.body, true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, body []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &memoryCacheEntry{key: key, body: body, expires: expiry(time.Now(), ttl)}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// DiskCache is a Cache storing each response as a file in a directory, so
+// that cached responses survive restarts.
+type DiskCache struct {
+	dir string
+}
+
+type diskCacheEntry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// NewDiskCache constructs a DiskCache storing responses in dir, creating
+// the directory if it doesn't exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Cache.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	contents, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry diskCacheEntry
+	if err := json.Unmarshal(contents, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+	if expired(entry.Expires, time.Now()) {
+		os.Remove(c.path(key))
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// Set implements Cache. Failures to write are ignored; the response is
+// simply not cached.
+func (c *DiskCache) Set(key string, body []byte, ttl time.Duration) {
+	contents, err := json.Marshal(diskCacheEntry{
+		Key:     key,
+		Expires: expiry(time.Now(), ttl),
+		Body:    body,
+	})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file first so that readers never observe a
+	// partially written entry.
+	tmp, err := ioutil.TempFile(c.dir, "tmp-")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(contents)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// CachePolicy decides how long responses are cached, based on how volatile
+// their data is. Responses describing games that are Final, or games from
+// past seasons, are cached forever. Live games expire quickly.
+type CachePolicy struct {
+	// LiveTTL is how long responses describing a live game are cached.
+	LiveTTL time.Duration
+	// ScheduledTTL is how long responses describing games that haven't
+	// started are cached.
+	ScheduledTTL time.Duration
+	// ReferenceTTL is how long responses that don't describe a game, like
+	// teams and players, are cached.
+	ReferenceTTL time.Duration
+
+	finals sync.Map // data.GameID -> struct{}
+}
+
+// NewCachePolicy constructs a CachePolicy with the default TTLs.
+func NewCachePolicy() *CachePolicy {
+	return &CachePolicy{
+		LiveTTL:      30 * time.Second,
+		ScheduledTTL: 5 * time.Minute,
+		ReferenceTTL: 6 * time.Hour,
+	}
+}
+
+// Observe records the status of any games in the response, so that later
+// responses about the same games may be cached appropriately. It's called
+// for every response, including those served from the cache.
+func (p *CachePolicy) Observe(resp *Response) {
+	for _, status := range gameStatuses(resp) {
+		if status.status == data.Final {
+			p.finals.Store(status.gameID, struct{}{})
+		}
+	}
+}
+
+// TTL returns how long the response from the endpoint should be cached. A
+// TTL of zero means the response should not be cached.
+func (p *CachePolicy) TTL(endpoint string, params url.Values, resp *Response) time.Duration {
+	if statuses := gameStatuses(resp); len(statuses) > 0 {
+		ttl := Forever
+		for _, status := range statuses {
+			switch status.status {
+			case data.Final:
+			case data.Live:
+				return p.LiveTTL
+			default:
+				ttl = p.ScheduledTTL
+			}
+		}
+		return ttl
+	}
+
+	// Responses about a single game don't carry its status, but they stop
+	// changing once the game is over.
+	if gameID := data.GameID(params.Get("GameID")); gameID != "" {
+		if p.isFinal(gameID) {
+			return Forever
+		}
+		return p.LiveTTL
+	}
+	return p.ReferenceTTL
+}
+
+func (p *CachePolicy) isFinal(gameID data.GameID) bool {
+	if season := gameID.Season(); season != "" && season.FallYear() < data.CurrentSeason.FallYear() {
+		return true
+	}
+	_, ok := p.finals.Load(gameID)
+	return ok
+}
+
+type gameStatus struct {
+	gameID data.GameID
+	status data.GameStatus
+}
+
+// gameStatuses returns the status of every game described by a result set
+// in the response, like the 'GameSummary' and 'GameHeader' result sets.
+func gameStatuses(resp *Response) []gameStatus {
+	statuses := resp.statuses
+	for _, rs := range resp.ResultSets {
+		columns := newStatusColumns(rs.makeHeaderMap())
+		for _, row := range rs.RowSet {
+			if len(row) != len(rs.Headers) {
+				continue
+			}
+			if status, ok := columns.status(row); ok {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses
+}
+
+// statusColumns are the indexes of the columns of a result set holding a
+// game ID and status, or -1 if it doesn't have them.
+type statusColumns struct {
+	gameIDColumn, statusColumn int
+}
+
+func newStatusColumns(headers map[string]int) statusColumns {
+	gameIDIdx, ok := headers["GAME_ID"]
+	if !ok {
+		return statusColumns{-1, -1}
+	}
+	statusIdx, ok := headers["GAME_STATUS_ID"]
+	if !ok {
+		return statusColumns{-1, -1}
+	}
+	return statusColumns{gameIDIdx, statusIdx}
+}
+
+// status returns the status of the game described by a row.
+func (c statusColumns) status(row []interface{}) (gameStatus, bool) {
+	if c.gameIDColumn < 0 {
+		return gameStatus{}, false
+	}
+	gameID, _ := row[c.gameIDColumn].(string)
+	statusID, _ := row[c.statusColumn].(float64)
+	return gameStatus{
+		gameID: data.GameID(gameID),
+		status: ConvertGameStatus(int(statusID)),
+	}, true
+}
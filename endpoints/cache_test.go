@@ -0,0 +1,134 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), Forever)
+	cache.Set("b", []byte("2"), Forever)
+	cache.Get("a")
+	cache.Set("c", []byte("3"), Forever)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to have been evicted")
+	}
+	if body, ok := cache.Get("a"); !ok || string(body) != "1" {
+		t.Errorf("expected a to be cached, got %q, %v", body, ok)
+	}
+	if body, ok := cache.Get("c"); !ok || string(body) != "3" {
+		t.Errorf("expected c to be cached, got %q, %v", body, ok)
+	}
+}
+
+func TestMemoryCacheExpires(t *testing.T) {
+	cache := NewMemoryCache(10)
+	cache.Set("a", []byte("1"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected a to have expired")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Set("boxscoresummaryv2?GameID=0021401185", []byte(`{"resultSets":[]}`), Forever)
+
+	body, ok := cache.Get("boxscoresummaryv2?GameID=0021401185")
+	if !ok || string(body) != `{"resultSets":[]}` {
+		t.Errorf("expected the cached body, got %q, %v", body, ok)
+	}
+	if _, ok := cache.Get("boxscoresummaryv2?GameID=0021401186"); ok {
+		t.Error("expected a miss for a different key")
+	}
+}
+
+func gameSummaryResponse(gameID string, statusID int) *Response {
+	return &Response{
+		ResultSets: []*ResultSet{{
+			Name:    "GameSummary",
+			Headers: []string{"GAME_ID", "GAME_STATUS_ID"},
+			RowSet:  [][]interface{}{{gameID, float64(statusID)}},
+		}},
+	}
+}
+
+func TestCachePolicyTTL(t *testing.T) {
+	policy := NewCachePolicy()
+	// Use a game from the current season so its season doesn't make it
+	// final.
+	gameID := fmt.Sprintf("002%02d00001", data.CurrentSeason.FallYear()%100)
+	params := url.Values{"GameID": {gameID}}
+
+	if ttl := policy.TTL("boxscoresummaryv2", params, gameSummaryResponse(gameID, 2)); ttl != policy.LiveTTL {
+		t.Errorf("expected a live game to be cached for %v, got %v", policy.LiveTTL, ttl)
+	}
+	if ttl := policy.TTL("boxscoretraditionalv2", params, &Response{}); ttl != policy.LiveTTL {
+		t.Errorf("expected an unfinished game's box score to be cached for %v, got %v", policy.LiveTTL, ttl)
+	}
+
+	final := gameSummaryResponse(gameID, 3)
+	policy.Observe(final)
+	if ttl := policy.TTL("boxscoresummaryv2", params, final); ttl != Forever {
+		t.Errorf("expected a final game to be cached forever, got %v", ttl)
+	}
+	if ttl := policy.TTL("boxscoretraditionalv2", params, &Response{}); ttl != Forever {
+		t.Errorf("expected a final game's box score to be cached forever, got %v", ttl)
+	}
+
+	pastParams := url.Values{"GameID": {"0021401185"}}
+	if ttl := policy.TTL("playbyplayv2", pastParams, &Response{}); ttl != Forever {
+		t.Errorf("expected a past season's game to be cached forever, got %v", ttl)
+	}
+	if ttl := policy.TTL("franchisehistory", url.Values{}, &Response{}); ttl != policy.ReferenceTTL {
+		t.Errorf("expected reference data to be cached for %v, got %v", policy.ReferenceTTL, ttl)
+	}
+}
+
+type testGameStatusResponse struct {
+	GameSummary []*struct {
+		GameID       string `nbagame:"GAME_ID"`
+		GameStatusID int    `nbagame:"GAME_STATUS_ID"`
+	} `nbagame:"GameSummary"`
+}
+
+func TestRequestUsesCache(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"resultSets": [{"name": "GameSummary",
+			"headers": ["GAME_ID", "GAME_STATUS_ID"], "rowSet": [["0021401185", 3]]}]}`))
+	}))
+	defer server.Close()
+
+	requester := Requester{
+		Domain: strings.TrimPrefix(server.URL, "http://"),
+		Cache:  NewMemoryCache(10),
+	}
+	for i := 0; i < 3; i++ {
+		var resp testGameStatusResponse
+		err := requester.Request(context.Background(), "boxscoresummaryv2", BoxScoreSummaryParams{GameID: "0021401185"}, &resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.GameSummary) != 1 {
+			t.Fatalf("expected a game summary, got %+v", resp.GameSummary)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request to the server, got %v", requests)
+	}
+}
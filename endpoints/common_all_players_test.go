@@ -0,0 +1,65 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestCommonAllPlayers(t *testing.T) {
+	var resp CommonAllPlayersResponse
+	if err := testRequester.Request(context.Background(), "commonallplayers", &CommonAllPlayersParams{
+		LeagueID:            "00",
+		Season:              "2014-15",
+		IsOnlyCurrentSeason: 0,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	players := resp.Present()
+	if len(players) < 100 {
+		t.Fatalf("expected at least 100 players, got %v", len(players))
+	}
+
+	var westbrook *data.Player
+	var inactive int
+	for _, player := range players {
+		if player.ID == 201566 {
+			westbrook = player
+		}
+		if player.RosterStatus == data.Inactive {
+			inactive++
+		}
+	}
+	if westbrook == nil {
+		t.Fatal("expected to find Russell Westbrook")
+	}
+	if westbrook.FirstName != "Russell" || westbrook.LastName != "Westbrook" {
+		t.Errorf("expected the name to be split on the comma, got %+v", westbrook)
+	}
+	if westbrook.RosterStatus != data.Active || westbrook.CareerStartYear != "2008" {
+		t.Errorf("unexpected player: %+v", westbrook)
+	}
+	if inactive == 0 {
+		t.Error("expected historical players to be inactive")
+	}
+}
+
+func TestCommonAllPlayersRowNames(t *testing.T) {
+	tests := []struct {
+		fullName  string
+		firstName string
+		lastName  string
+	}{
+		{"Westbrook, Russell", "Russell", "Westbrook"},
+		{"Nene", "", "Nene"},
+	}
+	for _, test := range tests {
+		player := (&CommonAllPlayersRow{FullName: test.fullName}).ToPlayer()
+		if player.FirstName != test.firstName || player.LastName != test.lastName {
+			t.Errorf("%q: expected %q %q, got %q %q", test.fullName,
+				test.firstName, test.lastName, player.FirstName, player.LastName)
+		}
+	}
+}
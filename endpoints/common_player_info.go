@@ -1,6 +1,7 @@
 package endpoints
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -50,6 +51,9 @@ type CommonPlayerInfoRow struct {
 	FromYear         int    `nbagame:"FROM_YEAR"`
 	ToYear           int    `nbagame:"TO_YEAR"`
 	DLeagueFlag      string `nbagame:"DLEAGUE_FLAG"`
+	DraftYear        string `nbagame:"DRAFT_YEAR"`
+	DraftRound       string `nbagame:"DRAFT_ROUND"`
+	DraftNumber      string `nbagame:"DRAFT_NUMBER"`
 }
 
 // ToPlayerDetails converts a row to a PlayerDetails struct.
@@ -100,5 +104,29 @@ func (r *CommonPlayerInfoRow) ToPlayerDetails() (*data.PlayerDetails, error) {
 		playerDetails.DLeague = true
 	}
 
+	// Convert the draft into integers.
+	if playerDetails.DraftYear, err = parseDraftField("DRAFT_YEAR", r.DraftYear); err != nil {
+		return nil, err
+	}
+	if playerDetails.DraftRound, err = parseDraftField("DRAFT_ROUND", r.DraftRound); err != nil {
+		return nil, err
+	}
+	if playerDetails.DraftNumber, err = parseDraftField("DRAFT_NUMBER", r.DraftNumber); err != nil {
+		return nil, err
+	}
+
 	return playerDetails, nil
 }
+
+// parseDraftField parses one of the draft columns of a commonplayerinfo row.
+// Undrafted players have "Undrafted" in each of them, which is left zero.
+func parseDraftField(header, value string) (int, error) {
+	if value == "" || value == "Undrafted" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, ErrBadResponse(fmt.Sprintf("unexpected %s %q", header, value))
+	}
+	return n, nil
+}
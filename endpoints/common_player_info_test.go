@@ -1,6 +1,10 @@
 package endpoints
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"testing"
+)
 
 func TestCommonPlayerInfo(t *testing.T) {
 	params := CommonPlayerInfoParams{
@@ -9,7 +13,7 @@ func TestCommonPlayerInfo(t *testing.T) {
 	}
 
 	var resp CommonPlayerInfoResponse
-	if err := DefaultRequester.Request("commonplayerinfo", params, &resp); err != nil {
+	if err := testRequester.Request(context.Background(), "commonplayerinfo", params, &resp); err != nil {
 		t.Fatal(err)
 	}
 	if len(resp.CommonPlayerInfo) == 0 {
@@ -29,4 +33,28 @@ func TestCommonPlayerInfo(t *testing.T) {
 		t.Errorf("expected player details to have birthdate %s but got %+v",
 			row.Birthdate, details)
 	}
+	if details.DraftYear != 2008 || details.DraftRound != 1 || details.DraftNumber != 4 {
+		t.Errorf("expected Russell Westbrook to be the 4th pick of the 2008 draft, got %+v", details)
+	}
+}
+
+func TestCommonPlayerInfoDraft(t *testing.T) {
+	row := CommonPlayerInfoRow{
+		Birthdate:   "1988-10-27T00:00:00",
+		DraftYear:   "Undrafted",
+		DraftRound:  "Undrafted",
+		DraftNumber: "Undrafted",
+	}
+	details, err := row.ToPlayerDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.DraftYear != 0 || details.DraftRound != 0 || details.DraftNumber != 0 {
+		t.Errorf("expected an undrafted player to have no draft, got %+v", details)
+	}
+
+	row.DraftNumber = "4th"
+	if _, err := row.ToPlayerDetails(); !errors.Is(err, ErrSchemaMismatch) {
+		t.Errorf("expected a schema mismatch for an unparsable draft number, got %v", err)
+	}
 }
@@ -0,0 +1,131 @@
+package endpoints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// CommonTeamRosterParams defines parameters for a CommonTeamRoster request.
+// http://stats.nba.com/stats/commonteamroster?LeagueID=00&Season=2014-15&TeamID=1610612737
+type CommonTeamRosterParams struct {
+	LeagueID string `json:"LeagueID"`
+	Season   string `json:"Season"`
+	TeamID   int    `json:"TeamID"`
+}
+
+// CommonTeamRosterResponse is the type for all result sets returned by the
+// 'commonteamroster' resource.
+type CommonTeamRosterResponse struct {
+	CommonTeamRoster []*CommonTeamRosterRow `nbagame:"CommonTeamRoster"`
+	Coaches          []*CoachRow            `nbagame:"Coaches"`
+}
+
+// ToData returns a nbagame.data representation of this response. The rows
+// only identify the season by its fall year, so it's passed in.
+func (resp *CommonTeamRosterResponse) ToData(season data.Season, teamID int) (*data.Roster, error) {
+	roster := &data.Roster{TeamID: teamID, Season: season}
+	for _, r := range resp.CommonTeamRoster {
+		entry, err := r.ToRosterEntry(season)
+		if err != nil {
+			return nil, err
+		}
+		roster.Players = append(roster.Players, entry)
+	}
+	for _, r := range resp.Coaches {
+		roster.Coaches = append(roster.Coaches, r.ToCoach(season))
+	}
+	return roster, nil
+}
+
+// CommonTeamRosterRow represents the schema returned for 'CommonTeamRoster'
+// result sets, returned from the 'commonteamroster' resource.
+type CommonTeamRosterRow struct {
+	TeamID      int       `nbagame:"TeamID"`
+	Season      string    `nbagame:"SEASON"`
+	LeagueID    string    `nbagame:"LeagueID"`
+	Player      string    `nbagame:"PLAYER"`
+	Number      string    `nbagame:"NUM"`
+	Position    string    `nbagame:"POSITION"`
+	Height      int       `nbagame:"HEIGHT,inches"`
+	Weight      string    `nbagame:"WEIGHT"`
+	Birthdate   time.Time `nbagame:"BIRTH_DATE,date"`
+	Age         float64   `nbagame:"AGE"`
+	Experience  string    `nbagame:"EXP"`
+	School      string    `nbagame:"SCHOOL"`
+	PlayerID    int       `nbagame:"PLAYER_ID"`
+	HowAcquired string    `nbagame:"HOW_ACQUIRED"`
+}
+
+// ToRosterEntry converts this row into a RosterEntry data struct.
+func (r *CommonTeamRosterRow) ToRosterEntry(season data.Season) (*data.RosterEntry, error) {
+	entry := &data.RosterEntry{
+		PlayerID:    r.PlayerID,
+		PlayerName:  r.Player,
+		TeamID:      r.TeamID,
+		Season:      season,
+		Jersey:      strings.TrimSpace(r.Number),
+		Position:    strings.TrimSpace(r.Position),
+		Height:      r.Height,
+		Age:         r.Age,
+		School:      r.School,
+		HowAcquired: r.HowAcquired,
+	}
+	if !r.Birthdate.IsZero() {
+		birthdate := data.Date(r.Birthdate)
+		entry.Birthdate = &birthdate
+	}
+
+	// Weight is a string, and experience is "R" for rookies.
+	var err error
+	if entry.Weight, err = parseRosterField("WEIGHT", r.Weight); err != nil {
+		return nil, err
+	}
+	if entry.Experience, err = parseRosterField("EXP", r.Experience); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// parseRosterField parses one of the numeric string columns of a
+// commonteamroster row. Blank cells, and the "R" rookies have for their
+// experience, are left zero.
+func parseRosterField(header, value string) (int, error) {
+	if value == "" || value == "R" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, ErrBadResponse(fmt.Sprintf("unexpected %s %q", header, value))
+	}
+	return n, nil
+}
+
+// CoachRow represents the schema returned for 'Coaches' result sets,
+// returned from the 'commonteamroster' resource.
+type CoachRow struct {
+	TeamID    int    `nbagame:"TEAM_ID"`
+	Season    string `nbagame:"SEASON"`
+	CoachID   int    `nbagame:"COACH_ID"`
+	FirstName string `nbagame:"FIRST_NAME"`
+	LastName  string `nbagame:"LAST_NAME"`
+	CoachName string `nbagame:"COACH_NAME"`
+	CoachType string `nbagame:"COACH_TYPE"`
+	School    string `nbagame:"SCHOOL"`
+}
+
+// ToCoach converts this row into a Coach data struct.
+func (r *CoachRow) ToCoach(season data.Season) *data.Coach {
+	return &data.Coach{
+		CoachID:   r.CoachID,
+		FirstName: r.FirstName,
+		LastName:  r.LastName,
+		TeamID:    r.TeamID,
+		Season:    season,
+		Type:      r.CoachType,
+		School:    r.School,
+	}
+}
@@ -0,0 +1,66 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCommonTeamRoster(t *testing.T) {
+	var resp CommonTeamRosterResponse
+	if err := testRequester.Request(context.Background(), "commonteamroster", &CommonTeamRosterParams{
+		LeagueID: "00",
+		Season:   "2014-15",
+		TeamID:   1610612737,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	roster, err := resp.ToData("2014-15", 1610612737)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roster.Players) != 13 {
+		t.Fatalf("expected 13 players, got %v", len(roster.Players))
+	}
+	for _, player := range roster.Players {
+		if player.TeamID != 1610612737 || player.Season != "2014-15" {
+			t.Errorf("expected a 2014-15 Hawk, got %+v", player)
+		}
+		if player.Height < 70 || player.Weight < 150 || player.Birthdate == nil || player.HowAcquired == "" {
+			t.Errorf("expected player details, got %+v", player)
+		}
+	}
+
+	korver := roster.Players[1]
+	if korver.PlayerID != 2594 || korver.Jersey != "26" || korver.Height != 79 || korver.Experience != 11 {
+		t.Errorf("unexpected details for Kyle Korver: %+v", korver)
+	}
+	if birthdate := time.Time(*korver.Birthdate); birthdate.Year() != 1981 || birthdate.Month() != time.March || birthdate.Day() != 17 {
+		t.Errorf("unexpected birthdate for Kyle Korver: %v", korver.Birthdate)
+	}
+	if rookie := roster.Players[11]; rookie.PlayerName != "Mike Muscala" || rookie.Experience != 0 {
+		t.Errorf("expected Mike Muscala to be a rookie, got %+v", rookie)
+	}
+
+	var headCoaches int
+	for _, coach := range roster.Coaches {
+		if coach.HeadCoach() {
+			headCoaches++
+			if coach.LastName != "Budenholzer" {
+				t.Errorf("expected Mike Budenholzer to be the head coach, got %+v", coach)
+			}
+		}
+	}
+	if len(roster.Coaches) < 2 || headCoaches != 1 {
+		t.Errorf("expected one head coach and assistants, got %v coaches", len(roster.Coaches))
+	}
+}
+
+func TestCommonTeamRosterBadWeight(t *testing.T) {
+	row := CommonTeamRosterRow{Weight: "n/a", Experience: "R"}
+	if _, err := row.ToRosterEntry("2014-15"); !errors.Is(err, ErrSchemaMismatch) {
+		t.Errorf("expected a schema mismatch for an unparsable weight, got %v", err)
+	}
+}
@@ -11,17 +11,27 @@ const (
 )
 
 // ErrBadResponse is returned when a response is not formatted as expected.
+// It matches ErrSchemaMismatch.
 type ErrBadResponse string
 
 func (err ErrBadResponse) Error() string {
 	return fmt.Sprintf("Bad stats.nba.com response: %s", string(err))
 }
 
+// Is reports whether target is ErrSchemaMismatch.
+func (err ErrBadResponse) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
 // Response represents a results from the stats.nba.com API.
 type Response struct {
 	Resource   string       `json:"resource"`
 	Parameters interface{}  `json:"parameters"`
 	ResultSets []*ResultSet `json:"resultSets"`
+
+	// statuses holds the status of games described by rows that were
+	// streamed rather than kept in a RowSet.
+	statuses []gameStatus
 }
 
 // ResultSet is a set of results returned for a resource from the stats.nba.com
@@ -30,6 +40,10 @@ type ResultSet struct {
 	Name    string          `json:"name"`
 	Headers []string        `json:"headers"`
 	RowSet  [][]interface{} `json:"rowSet"`
+
+	// streamedRows counts the rows that were streamed rather than kept in
+	// the RowSet.
+	streamedRows int
 }
 
 // NewResponse constructs a new Response from a byte array contianing the returned
@@ -52,66 +66,95 @@ func (r *Response) ResultSetByName(name string) *ResultSet {
 // Decode decodes the Response into an appropriate resource-specific Response
 // struct.
 func (r *Response) Decode(v interface{}) error {
+	_, err := r.DecodeWith(v, DecodeDefault)
+	return err
+}
+
+// DecodeWith decodes the Response like Decode, treating differences between
+// the response and the struct according to mode. It returns the SchemaDrift
+// it finds, or nil if there is none.
+func (r *Response) DecodeWith(v interface{}, mode DecodeMode) (*SchemaDrift, error) {
+	fields, err := resultSetFields(v)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &SchemaDrift{}
+	for _, field := range fields {
+		resultSet := r.ResultSetByName(field.name)
+		if resultSet == nil {
+			if mode != DecodeDefault {
+				drift.MissingResultSets = append(drift.MissingResultSets, field.name)
+			}
+			continue
+		}
+
+		rsDrift, err := resultSet.DecodeWith(field.value.Addr().Interface(), mode)
+		if rsDrift != nil {
+			drift.ResultSets = append(drift.ResultSets, rsDrift)
+		}
+		if err != nil {
+			return drift.orNil(), err
+		}
+	}
+
+	if mode == DecodeStrict && !drift.empty() {
+		return drift, drift
+	}
+	return drift.orNil(), nil
+}
+
+// resultSetField is a field of a resource-specific Response struct that a
+// result set is decoded into.
+type resultSetField struct {
+	name  string
+	value reflect.Value
+}
+
+// resultSetFields returns the fields of the Response struct v that result
+// sets are decoded into.
+func resultSetFields(v interface{}) ([]resultSetField, error) {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
-		return &InvalidDecodeError{rv}
+		return nil, &InvalidDecodeError{rv}
 	}
 	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
 		rv = rv.Elem()
 	}
 
+	var fields []resultSetField
 	for i := 0; i < rv.NumField(); i++ {
-		fieldValue := rv.Field(i)
-		fieldType := rv.Type().Field(i)
-
-		resultSetName := fieldType.Tag.Get(tagKey)
+		resultSetName, _ := parseTag(rv.Type().Field(i).Tag.Get(tagKey))
 		if resultSetName == "" {
 			// Skip any fields that don't have a tag.
 			continue
 		}
-
-		resultSet := r.ResultSetByName(resultSetName)
-		if resultSet == nil {
-			continue
-		}
-
-		if err := resultSet.Decode(fieldValue.Addr().Interface()); err != nil {
-			return err
-		}
+		fields = append(fields, resultSetField{name: resultSetName, value: rv.Field(i)})
 	}
-	return nil
+	return fields, nil
 }
 
 // Decode decodes the ResultSet into a slice of the appropriate result set
 // structs.
 func (rs *ResultSet) Decode(v interface{}) error {
-	rv := reflect.ValueOf(v)
-	if rv.Kind() != reflect.Ptr || rv.IsNil() {
-		return &InvalidDecodeError{rv}
-	}
+	_, err := rs.DecodeWith(v, DecodeDefault)
+	return err
+}
 
-	slice := rv.Elem()
-	if slice.Kind() != reflect.Slice {
-		return &InvalidDecodeError{rv}
+// DecodeWith decodes the ResultSet like Decode, treating differences between
+// its headers and the struct according to mode. It returns the drift it
+// finds, or nil if there is none.
+func (rs *ResultSet) DecodeWith(v interface{}, mode DecodeMode) (*ResultSetDrift, error) {
+	decoder, err := rs.rowDecoder(v, mode)
+	if err != nil {
+		return nil, err
 	}
-
-	// TODO: More error checking on v.
-	sliceType := slice.Type().Elem().Elem()
-
-	headers := rs.makeHeaderMap()
 	for _, row := range rs.RowSet {
-		if len(row) != len(rs.Headers) {
-			return fmt.Errorf("the result set headers contain %v columns, row contains %v", len(rs.Headers), len(row))
+		if err := decoder.decode(row); err != nil {
+			return decoder.drift, err
 		}
-
-		newValue := reflect.New(sliceType)
-		if err := populateValue(newValue.Elem(), row, headers); err != nil {
-			return err
-		}
-		slice.Set(reflect.Append(slice, newValue))
 	}
-
-	return nil
+	return decoder.drift, nil
 }
 
 func (rs *ResultSet) makeHeaderMap() map[string]int {
@@ -122,54 +165,91 @@ func (rs *ResultSet) makeHeaderMap() map[string]int {
 	return m
 }
 
-func populateValue(v reflect.Value, row []interface{}, headers map[string]int) error {
-	for i := 0; i < v.NumField(); i++ {
-		fieldValue := v.Field(i)
-		fieldType := v.Type().Field(i)
+// rowDecoder decodes rows of a result set, one at a time, into a slice of
+// structs.
+type rowDecoder struct {
+	rs       *ResultSet
+	slice    reflect.Value
+	elemType reflect.Type
+	plan     *decodePlan
+	columns  []int
+	drift    *ResultSetDrift
+	mode     DecodeMode
+}
 
-		if fieldValue.Kind() == reflect.Struct {
-			// Decode into any embedded structs too
-			if err := populateValue(fieldValue, row, headers); err != nil {
-				return err
-			}
-			continue
-		}
+// rowDecoder returns a rowDecoder for the result set's rows into v, which
+// must be a pointer to a slice of struct pointers. Only the result set's
+// name and headers are used.
+func (rs *ResultSet) rowDecoder(v interface{}, mode DecodeMode) (*rowDecoder, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, &InvalidDecodeError{rv}
+	}
 
-		fieldHeader := fieldType.Tag.Get(tagKey)
-		if fieldHeader == "" {
-			// Skip any fields that don't have a tag.
-			continue
-		}
+	slice := rv.Elem()
+	if slice.Kind() != reflect.Slice {
+		return nil, &InvalidDecodeError{rv}
+	}
 
-		headerIndex, ok := headers[fieldHeader]
-		if !ok {
-			return fmt.Errorf("header `%s` does not exist in %+v", fieldHeader, headers)
-		}
+	// TODO: More error checking on v.
+	elemType := slice.Type().Elem().Elem()
+	plan, err := planFor(elemType)
+	if err != nil {
+		return nil, err
+	}
 
-		rowValue := reflect.ValueOf(row[headerIndex])
-		if !rowValue.IsValid() {
-			continue
-		}
-		rowValueType := rowValue.Type()
-		fieldValueType := fieldValue.Type()
+	headers := rs.makeHeaderMap()
+	return &rowDecoder{
+		rs:       rs,
+		slice:    slice,
+		elemType: elemType,
+		plan:     plan,
+		columns:  plan.columns(headers),
+		drift:    rs.drift(plan, headers, mode),
+		mode:     mode,
+	}, nil
+}
 
-		if rowValueType.ConvertibleTo(fieldValueType) {
-			fieldValue.Set(rowValue.Convert(fieldValue.Type()))
-			continue
-		}
+// decode decodes a row, appending it to the slice.
+func (d *rowDecoder) decode(row []interface{}) error {
+	if d.drift != nil && d.mode == DecodeDefault {
+		return ErrBadResponse(fmt.Sprintf("header `%s` does not exist in %v", d.drift.MissingHeaders[0], d.rs.Headers))
+	}
+	if len(row) != len(d.rs.Headers) {
+		return ErrBadResponse(fmt.Sprintf("the result set headers contain %v columns, row contains %v", len(d.rs.Headers), len(row)))
+	}
 
-		if fieldValueType.Kind() == reflect.Ptr && rowValueType.ConvertibleTo(fieldValueType.Elem()) {
-			// The field is a pointer to the right type.
-			elemValue := reflect.New(fieldValue.Type().Elem())
-			reflect.Indirect(elemValue).Set(rowValue.Convert(fieldValue.Type().Elem()))
-			fieldValue.Set(elemValue)
-			continue
-		}
+	newValue := reflect.New(d.elemType)
+	if err := d.plan.populate(newValue.Elem(), row, d.columns); err != nil {
+		return err
+	}
+	d.slice.Set(reflect.Append(d.slice, newValue))
+	return nil
+}
 
-		return fmt.Errorf("cannot convert `%v` to `%v` for %s", rowValue.Type(), fieldValue.Type(), fieldHeader)
+// drift compares the result set's headers with those expected by the plan,
+// returning nil if they match.
+func (rs *ResultSet) drift(plan *decodePlan, headers map[string]int, mode DecodeMode) *ResultSetDrift {
+	drift := &ResultSetDrift{Name: rs.Name}
+	expected := make(map[string]bool)
+	for _, header := range plan.headers {
+		expected[header] = true
+		if _, ok := headers[header]; !ok {
+			drift.MissingHeaders = append(drift.MissingHeaders, header)
+		}
+	}
+	if mode == DecodeStrict {
+		for _, header := range rs.Headers {
+			if !expected[header] {
+				drift.UnknownHeaders = append(drift.UnknownHeaders, header)
+			}
+		}
+	}
 
+	if drift.empty() {
+		return nil
 	}
-	return nil
+	return drift
 }
 
 // An InvalidDecodeError describes an invalid argument passed to Decode.
@@ -1,6 +1,9 @@
 package endpoints
 
 import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -37,3 +40,150 @@ func TestSimpleDecoderExample(t *testing.T) {
 		t.Errorf("Populated players didn't match expected: %+v", players)
 	}
 }
+
+type testPlayerResponse struct {
+	Players []*testPlayer `nbagame:"Players"`
+	Coaches []*testPlayer `nbagame:"Coaches"`
+}
+
+func testDriftingResponse() *Response {
+	return &Response{
+		ResultSets: []*ResultSet{
+			{
+				Name:    "Players",
+				Headers: []string{"PLAYER_ID", "DISPLAY_NAME", "TEAM_ID"},
+				RowSet: [][]interface{}{
+					[]interface{}{20, "Delonte West", 1610612739},
+				},
+			},
+		},
+	}
+}
+
+func TestDecodeModes(t *testing.T) {
+	var resp testPlayerResponse
+	drift, err := testDriftingResponse().DecodeWith(&resp, DecodeDefault)
+	if !errors.Is(err, ErrSchemaMismatch) {
+		t.Errorf("expected a missing header to fail, got %v", err)
+	}
+	if drift == nil || len(drift.ResultSets) != 1 || !reflect.DeepEqual(drift.ResultSets[0].MissingHeaders, []string{"NAME"}) {
+		t.Errorf("expected drift for the missing header, got %+v", drift)
+	}
+
+	resp = testPlayerResponse{}
+	drift, err = testDriftingResponse().DecodeWith(&resp, DecodeLenient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.Players, []*testPlayer{{PlayerID: 20}}) {
+		t.Errorf("expected the name to be left empty, got %+v", resp.Players)
+	}
+	expected := &SchemaDrift{
+		MissingResultSets: []string{"Coaches"},
+		ResultSets:        []*ResultSetDrift{{Name: "Players", MissingHeaders: []string{"NAME"}}},
+	}
+	if !reflect.DeepEqual(drift, expected) {
+		t.Errorf("expected %+v, got %+v", expected, drift)
+	}
+
+	resp = testPlayerResponse{}
+	drift, err = testDriftingResponse().DecodeWith(&resp, DecodeStrict)
+	var driftErr *SchemaDrift
+	if !errors.As(err, &driftErr) || !errors.Is(err, ErrSchemaMismatch) {
+		t.Fatalf("expected SchemaDrift as the error, got %v", err)
+	}
+	expected.ResultSets[0].UnknownHeaders = []string{"DISPLAY_NAME", "TEAM_ID"}
+	if !reflect.DeepEqual(drift, expected) || driftErr != drift {
+		t.Errorf("expected %+v, got %+v", expected, drift)
+	}
+	if len(resp.Players) != 1 {
+		t.Errorf("expected the players to be decoded anyway, got %+v", resp.Players)
+	}
+}
+
+func TestDecodeWithoutDrift(t *testing.T) {
+	rs := &ResultSet{
+		Name:    "Players",
+		Headers: []string{"PLAYER_ID", "NAME"},
+		RowSet:  [][]interface{}{[]interface{}{295, "Chef Curry"}},
+	}
+	var players []*testPlayer
+	drift, err := rs.DecodeWith(&players, DecodeStrict)
+	if err != nil || drift != nil {
+		t.Errorf("expected no drift, got %v, %v", drift, err)
+	}
+}
+
+// benchmarkResponses pairs each endpoint recorded in testdata with the
+// struct its responses decode into.
+var benchmarkResponses = []struct {
+	endpoint string
+	resp     interface{}
+}{
+	{"boxscoreadvancedv2", BoxScoreAdvancedResponse{}},
+	{"boxscorefourfactorsv2", BoxScoreFourFactorsResponse{}},
+	{"boxscoremiscv2", BoxScoreMiscResponse{}},
+	{"boxscorescoringv2", BoxScoreScoringResponse{}},
+	{"boxscoresummaryv2", BoxScoreSummaryResponse{}},
+	{"boxscoretraditionalv2", BoxScoreTraditionalResponse{}},
+	{"boxscoreusagev2", BoxScoreUsageResponse{}},
+	{"commonallplayers", CommonAllPlayersResponse{}},
+	{"commonplayerinfo", CommonPlayerInfoResponse{}},
+	{"commonteamroster", CommonTeamRosterResponse{}},
+	{"draftcombineplayeranthro", DraftCombinePlayerAnthroResponse{}},
+	{"draftcombinestats", DraftCombineStatsResponse{}},
+	{"drafthistory", DraftHistoryResponse{}},
+	{"franchisehistory", FranchiseHistoryResponse{}},
+	{"leaguedashplayerstats", LeagueDashPlayerStatsResponse{}},
+	{"leaguedashteamstats", LeagueDashTeamAdvancedResponse{}},
+	{"leagueleaders", LeagueLeadersResponse{}},
+	{"leaguestandingsv3", LeagueStandingsResponse{}},
+	{"playbyplayv2", PlayByPlayResponse{}},
+	{"playercareerstats", PlayerCareerStatsResponse{}},
+	{"playergamelog", PlayerGameLogResponse{}},
+	{"scoreboardV2", ScoreboardResponse{}},
+	{"shotchartdetail", ShotChartDetailResponse{}},
+	{"teamgamelog", TeamGameLogResponse{}},
+}
+
+// benchmarkDecode runs decode over every response recorded in testdata.
+func benchmarkDecode(b *testing.B, decode func(body []byte, v interface{}) error) {
+	for _, bench := range benchmarkResponses {
+		paths, err := filepath.Glob(filepath.Join("testdata", bench.endpoint, "*.json"))
+		if err != nil || len(paths) == 0 {
+			b.Fatalf("no recorded responses for %s", bench.endpoint)
+		}
+		body, err := ioutil.ReadFile(paths[0])
+		if err != nil {
+			b.Fatal(err)
+		}
+		respType := reflect.TypeOf(bench.resp)
+
+		b.Run(bench.endpoint, func(b *testing.B) {
+			b.SetBytes(int64(len(body)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := decode(body, reflect.New(respType).Interface()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeBuffered(b *testing.B) {
+	benchmarkDecode(b, func(body []byte, v interface{}) error {
+		resp, err := NewResponse(body)
+		if err != nil {
+			return err
+		}
+		return resp.Decode(v)
+	})
+}
+
+func BenchmarkDecodeStreaming(b *testing.B) {
+	benchmarkDecode(b, func(body []byte, v interface{}) error {
+		_, _, err := streamResponse(body, v, DecodeDefault)
+		return err
+	})
+}
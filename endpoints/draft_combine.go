@@ -0,0 +1,127 @@
+package endpoints
+
+import (
+	"strconv"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// DraftCombineParams defines parameters for draftcombineplayeranthro and
+// draftcombinestats requests.
+// http://stats.nba.com/stats/draftcombineplayeranthro?LeagueID=00&SeasonYear=2014-15
+type DraftCombineParams struct {
+	LeagueID   string `json:"LeagueID"`
+	SeasonYear string `json:"SeasonYear"`
+}
+
+// DraftCombinePlayerAnthroResponse represents the response returned by the
+// draftcombineplayeranthro endpoint.
+type DraftCombinePlayerAnthroResponse struct {
+	Results []*DraftCombineAnthroRow `nbagame:"Results"`
+}
+
+// ToData returns a nbagame.data representation of this response. It only
+// holds the prospects' measurements; see DraftCombineStatsResponse.MergeInto
+// for their drill results.
+func (r *DraftCombinePlayerAnthroResponse) ToData(season data.Season) []*data.CombineMeasurement {
+	measurements := make([]*data.CombineMeasurement, 0, len(r.Results))
+	for _, row := range r.Results {
+		measurements = append(measurements, row.ToCombineMeasurement(season))
+	}
+	return measurements
+}
+
+// DraftCombineAnthroRow represents the schema returned for 'Results' result
+// sets, returned from the 'draftcombineplayeranthro' resource.
+type DraftCombineAnthroRow struct {
+	DraftCombinePlayer
+	HeightWithoutShoes *float64 `nbagame:"HEIGHT_WO_SHOES"`
+	HeightWithShoes    *float64 `nbagame:"HEIGHT_W_SHOES"`
+	Weight             string   `nbagame:"WEIGHT"`
+	Wingspan           *float64 `nbagame:"WINGSPAN"`
+	StandingReach      *float64 `nbagame:"STANDING_REACH"`
+	BodyFatPercentage  *float64 `nbagame:"BODY_FAT_PCT"`
+	HandLength         *float64 `nbagame:"HAND_LENGTH"`
+	HandWidth          *float64 `nbagame:"HAND_WIDTH"`
+}
+
+// ToCombineMeasurement converts this row into a CombineMeasurement data
+// struct.
+func (r *DraftCombineAnthroRow) ToCombineMeasurement(season data.Season) *data.CombineMeasurement {
+	m := r.DraftCombinePlayer.toCombineMeasurement(season)
+	m.HeightWithoutShoes = r.HeightWithoutShoes
+	m.HeightWithShoes = r.HeightWithShoes
+	if weight, err := strconv.ParseFloat(r.Weight, 64); err == nil {
+		m.Weight = &weight
+	}
+	m.Wingspan = r.Wingspan
+	m.StandingReach = r.StandingReach
+	m.BodyFatPercentage = r.BodyFatPercentage
+	m.HandLength = r.HandLength
+	m.HandWidth = r.HandWidth
+	return m
+}
+
+// DraftCombineStatsResponse represents the response returned by the
+// draftcombinestats endpoint.
+type DraftCombineStatsResponse struct {
+	DraftCombineStats []*DraftCombineStatsRow `nbagame:"DraftCombineStats"`
+}
+
+// MergeInto adds the prospects' drill results to measurements, matching them
+// by player ID. Prospects who weren't measured are appended.
+func (r *DraftCombineStatsResponse) MergeInto(measurements []*data.CombineMeasurement, season data.Season) []*data.CombineMeasurement {
+	byPlayer := make(map[int]*data.CombineMeasurement, len(measurements))
+	for _, m := range measurements {
+		byPlayer[m.PlayerID] = m
+	}
+	for _, row := range r.DraftCombineStats {
+		m, ok := byPlayer[row.PlayerID]
+		if !ok {
+			m = row.DraftCombinePlayer.toCombineMeasurement(season)
+			measurements = append(measurements, m)
+		}
+		m.StandingVerticalLeap = row.StandingVerticalLeap
+		m.MaxVerticalLeap = row.MaxVerticalLeap
+		m.LaneAgilityTime = row.LaneAgilityTime
+		m.ModifiedLaneAgilityTime = row.ModifiedLaneAgilityTime
+		m.ThreeQuarterSprint = row.ThreeQuarterSprint
+		m.BenchPress = row.BenchPress
+	}
+	return measurements
+}
+
+// DraftCombineStatsRow represents the schema returned for 'DraftCombineStats'
+// result sets, returned from the 'draftcombinestats' resource. It also
+// repeats the measurements from 'draftcombineplayeranthro', which aren't
+// decoded here.
+type DraftCombineStatsRow struct {
+	DraftCombinePlayer
+	StandingVerticalLeap    *float64 `nbagame:"STANDING_VERTICAL_LEAP"`
+	MaxVerticalLeap         *float64 `nbagame:"MAX_VERTICAL_LEAP"`
+	LaneAgilityTime         *float64 `nbagame:"LANE_AGILITY_TIME"`
+	ModifiedLaneAgilityTime *float64 `nbagame:"MODIFIED_LANE_AGILITY_TIME"`
+	ThreeQuarterSprint      *float64 `nbagame:"THREE_QUARTER_SPRINT"`
+	BenchPress              *int     `nbagame:"BENCH_PRESS"`
+}
+
+// DraftCombinePlayer contains the columns identifying a prospect that are
+// returned by both draft combine resources.
+type DraftCombinePlayer struct {
+	PlayerID   int    `nbagame:"PLAYER_ID"`
+	FirstName  string `nbagame:"FIRST_NAME"`
+	LastName   string `nbagame:"LAST_NAME"`
+	PlayerName string `nbagame:"PLAYER_NAME"`
+	Position   string `nbagame:"POSITION"`
+}
+
+func (p *DraftCombinePlayer) toCombineMeasurement(season data.Season) *data.CombineMeasurement {
+	return &data.CombineMeasurement{
+		PlayerID:   p.PlayerID,
+		Season:     season,
+		FirstName:  p.FirstName,
+		LastName:   p.LastName,
+		PlayerName: p.PlayerName,
+		Position:   p.Position,
+	}
+}
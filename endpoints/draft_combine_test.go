@@ -0,0 +1,39 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDraftCombine(t *testing.T) {
+	params := &DraftCombineParams{
+		LeagueID:   "00",
+		SeasonYear: "2014-15",
+	}
+	var anthro DraftCombinePlayerAnthroResponse
+	if err := testRequester.Request(context.Background(), "draftcombineplayeranthro", params, &anthro); err != nil {
+		t.Fatal(err)
+	}
+	var stats DraftCombineStatsResponse
+	if err := testRequester.Request(context.Background(), "draftcombinestats", params, &stats); err != nil {
+		t.Fatal(err)
+	}
+
+	measurements := stats.MergeInto(anthro.ToData("2014-15"), "2014-15")
+	if len(measurements) != len(anthro.Results) {
+		t.Fatalf("expected %v prospects, got %v", len(anthro.Results), len(measurements))
+	}
+	for _, m := range measurements {
+		if m.Season != "2014-15" || m.HeightWithoutShoes == nil || m.Weight == nil || m.Wingspan == nil {
+			t.Errorf("expected measurements, got %+v", m)
+		}
+		// Andrew Wiggins and Joel Embiid were measured, but skipped the drills.
+		if m.PlayerID == 203952 || m.PlayerID == 203954 {
+			if m.MaxVerticalLeap != nil || m.BenchPress != nil {
+				t.Errorf("expected %v to have skipped the drills, got %+v", m.PlayerName, m)
+			}
+		} else if m.MaxVerticalLeap == nil || m.BenchPress == nil {
+			t.Errorf("expected drill results, got %+v", m)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package endpoints
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// DraftHistoryParams defines parameters for a drafthistory request. Every
+// parameter but LeagueID is an optional filter.
+// http://stats.nba.com/stats/drafthistory?College=&LeagueID=00&OverallPick=&RoundNum=&RoundPick=&Season=2014&TeamID=&TopX=
+type DraftHistoryParams struct {
+	College     string `json:"College,omitempty"`
+	LeagueID    string `json:"LeagueID"`
+	OverallPick int    `json:"OverallPick,omitempty"`
+	RoundNum    int    `json:"RoundNum,omitempty"`
+	RoundPick   int    `json:"RoundPick,omitempty"`
+	Season      string `json:"Season,omitempty"`
+	TeamID      int    `json:"TeamID,omitempty"`
+	TopX        int    `json:"TopX,omitempty"`
+}
+
+// DraftHistoryResponse represents the response returned by the drafthistory
+// endpoint.
+type DraftHistoryResponse struct {
+	DraftHistory []*DraftHistoryRow `nbagame:"DraftHistory"`
+}
+
+// ToData returns a nbagame.data representation of this response, ordered by
+// draft and then by pick.
+func (r *DraftHistoryResponse) ToData() []*data.DraftPick {
+	picks := make([]*data.DraftPick, 0, len(r.DraftHistory))
+	for _, row := range r.DraftHistory {
+		picks = append(picks, row.ToDraftPick())
+	}
+	sort.SliceStable(picks, func(i, j int) bool {
+		if picks[i].Year != picks[j].Year {
+			return picks[i].Year < picks[j].Year
+		}
+		return picks[i].OverallPick < picks[j].OverallPick
+	})
+	return picks
+}
+
+// DraftHistoryRow represents the schema returned for 'DraftHistory' result
+// sets, returned from the 'drafthistory' resource.
+type DraftHistoryRow struct {
+	PersonID          int    `nbagame:"PERSON_ID"`
+	PlayerName        string `nbagame:"PLAYER_NAME"`
+	Season            string `nbagame:"SEASON"`
+	RoundNumber       int    `nbagame:"ROUND_NUMBER"`
+	RoundPick         int    `nbagame:"ROUND_PICK"`
+	OverallPick       int    `nbagame:"OVERALL_PICK"`
+	DraftType         string `nbagame:"DRAFT_TYPE"`
+	TeamID            int    `nbagame:"TEAM_ID"`
+	TeamCity          string `nbagame:"TEAM_CITY"`
+	TeamName          string `nbagame:"TEAM_NAME"`
+	TeamAbbreviation  string `nbagame:"TEAM_ABBREVIATION"`
+	Organization      string `nbagame:"ORGANIZATION"`
+	OrganizationType  string `nbagame:"ORGANIZATION_TYPE"`
+	PlayerProfileFlag int    `nbagame:"PLAYER_PROFILE_FLAG"`
+}
+
+// ToDraftPick converts this row into a DraftPick data struct.
+func (r *DraftHistoryRow) ToDraftPick() *data.DraftPick {
+	year, _ := strconv.Atoi(r.Season)
+	return &data.DraftPick{
+		PlayerID:         r.PersonID,
+		PlayerName:       r.PlayerName,
+		Year:             year,
+		Round:            r.RoundNumber,
+		RoundPick:        r.RoundPick,
+		OverallPick:      r.OverallPick,
+		TeamID:           r.TeamID,
+		TeamCity:         r.TeamCity,
+		TeamName:         r.TeamName,
+		TeamAbbreviation: r.TeamAbbreviation,
+		Organization:     r.Organization,
+		OrganizationType: r.OrganizationType,
+	}
+}
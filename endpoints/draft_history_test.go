@@ -0,0 +1,46 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDraftHistory(t *testing.T) {
+	var resp DraftHistoryResponse
+	if err := testRequester.Request(context.Background(), "drafthistory", &DraftHistoryParams{
+		LeagueID: "00",
+		RoundNum: 1,
+		Season:   "2014",
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	picks := resp.ToData()
+	if len(picks) != 30 {
+		t.Fatalf("expected 30 first round picks, got %v", len(picks))
+	}
+	for i, pick := range picks {
+		if pick.Year != 2014 || pick.Round != 1 || pick.OverallPick != i+1 {
+			t.Errorf("expected pick %v of the 2014 first round, got %+v", i+1, pick)
+		}
+	}
+
+	wiggins := picks[0]
+	if wiggins.PlayerName != "Andrew Wiggins" || wiggins.TeamAbbreviation != "CLE" || wiggins.Organization != "Kansas" {
+		t.Errorf("expected Andrew Wiggins to go first, got %+v", wiggins)
+	}
+}
+
+func TestDraftHistoryOrder(t *testing.T) {
+	resp := DraftHistoryResponse{DraftHistory: []*DraftHistoryRow{
+		{PersonID: 3, Season: "2014", OverallPick: 2},
+		{PersonID: 1, Season: "2013", OverallPick: 1},
+		{PersonID: 2, Season: "2014", OverallPick: 1},
+	}}
+	picks := resp.ToData()
+	for i, pick := range picks {
+		if pick.PlayerID != i+1 {
+			t.Fatalf("expected picks ordered by draft and then by pick, got %+v", picks)
+		}
+	}
+}
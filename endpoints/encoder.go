@@ -0,0 +1,154 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// ResultSetValueMarshaler is implemented by types that encode themselves as
+// a result set cell, the reverse of ResultSetValueUnmarshaler.
+type ResultSetValueMarshaler interface {
+	MarshalResultSetValue() (interface{}, error)
+}
+
+var resultSetValueMarshalerType = reflect.TypeOf((*ResultSetValueMarshaler)(nil)).Elem()
+
+// getter returns a field's value as a cell.
+type getter func(fieldValue reflect.Value) (interface{}, error)
+
+// Encode encodes a populated resource-specific Response struct, or a pointer
+// to one, like BoxScoreTraditionalResponse, as the JSON stats.nba.com
+// responds with. Each tagged slice becomes a result set, with the headers of
+// its struct's tagged fields, and a row for each element. Decoding the JSON
+// gives back the struct. It's useful for building test fixtures and fake
+// servers.
+func Encode(resp interface{}) ([]byte, error) {
+	response, err := encodeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(response)
+}
+
+// encodeResponse encodes a resource-specific Response struct as a Response.
+func encodeResponse(resp interface{}) (*Response, error) {
+	if rv := reflect.ValueOf(resp); rv.Kind() == reflect.Struct {
+		// Take a pointer to a copy, since the struct may be passed by value.
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		resp = ptr.Interface()
+	}
+	fields, err := resultSetFields(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		Parameters: map[string]interface{}{},
+		ResultSets: make([]*ResultSet, 0, len(fields)),
+	}
+	for _, field := range fields {
+		rs, err := encodeResultSet(field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		response.ResultSets = append(response.ResultSets, rs)
+	}
+	return response, nil
+}
+
+// encodeResultSet encodes a slice of structs, or pointers to them, as a
+// result set.
+func encodeResultSet(name string, slice reflect.Value) (*ResultSet, error) {
+	if slice.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("cannot encode %v as result set %s", slice.Type(), name)
+	}
+	elemType := slice.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	plan, err := planFor(elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &ResultSet{
+		Name:    name,
+		Headers: append([]string(nil), plan.headers...),
+		RowSet:  make([][]interface{}, 0, slice.Len()),
+	}
+	for i := 0; i < slice.Len(); i++ {
+		elem := reflect.Indirect(slice.Index(i))
+		if !elem.IsValid() {
+			continue
+		}
+		row, err := plan.row(elem)
+		if err != nil {
+			return nil, err
+		}
+		rs.RowSet = append(rs.RowSet, row)
+	}
+	return rs, nil
+}
+
+// row returns the cells of a row for the struct v.
+func (p *decodePlan) row(v reflect.Value) ([]interface{}, error) {
+	row := make([]interface{}, len(p.fields))
+	for i, field := range p.fields {
+		cell, err := field.get(v.FieldByIndex(field.index))
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %s: %s", field.header, err)
+		}
+		row[i] = cell
+	}
+	return row, nil
+}
+
+// getterFor returns the getter for a field of type t, according to its tag
+// option. Nil pointers are encoded as null.
+func getterFor(t reflect.Type, option string) getter {
+	switch {
+	case t.Implements(resultSetValueMarshalerType):
+		return func(fieldValue reflect.Value) (interface{}, error) {
+			if t.Kind() == reflect.Ptr && fieldValue.IsNil() {
+				return nil, nil
+			}
+			return fieldValue.Interface().(ResultSetValueMarshaler).MarshalResultSetValue()
+		}
+	case reflect.PtrTo(t).Implements(resultSetValueMarshalerType):
+		return func(fieldValue reflect.Value) (interface{}, error) {
+			return fieldValue.Addr().Interface().(ResultSetValueMarshaler).MarshalResultSetValue()
+		}
+	}
+	if t.Kind() == reflect.Ptr {
+		elem := getterFor(t.Elem(), option)
+		return func(fieldValue reflect.Value) (interface{}, error) {
+			if fieldValue.IsNil() {
+				return nil, nil
+			}
+			return elem(fieldValue.Elem())
+		}
+	}
+	if converter, ok := valueConverters[option]; ok {
+		return func(fieldValue reflect.Value) (interface{}, error) {
+			return converter.encode(fieldValue), nil
+		}
+	}
+
+	return func(fieldValue reflect.Value) (interface{}, error) {
+		switch fieldValue.Kind() {
+		case reflect.String:
+			return fieldValue.String(), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return fieldValue.Int(), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return fieldValue.Uint(), nil
+		case reflect.Float32, reflect.Float64:
+			return fieldValue.Float(), nil
+		case reflect.Bool:
+			return fieldValue.Bool(), nil
+		}
+		return fieldValue.Interface(), nil
+	}
+}
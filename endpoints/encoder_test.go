@@ -0,0 +1,82 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeRoundTrips(t *testing.T) {
+	for _, bench := range benchmarkResponses {
+		paths, err := filepath.Glob(filepath.Join("testdata", bench.endpoint, "*.json"))
+		if err != nil || len(paths) == 0 {
+			t.Fatalf("no recorded responses for %s", bench.endpoint)
+		}
+		body, err := ioutil.ReadFile(paths[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		respType := reflect.TypeOf(bench.resp)
+		decoded := reflect.New(respType).Interface()
+		if _, _, err := streamResponse(body, decoded, DecodeDefault); err != nil {
+			t.Fatal(err)
+		}
+		encoded, err := Encode(decoded)
+		if err != nil {
+			t.Fatalf("%s: %s", bench.endpoint, err)
+		}
+		redecoded := reflect.New(respType).Interface()
+		if _, _, err := streamResponse(encoded, redecoded, DecodeStrict); err != nil {
+			t.Fatalf("%s: %s", bench.endpoint, err)
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Errorf("%s: response didn't round trip through Encode", bench.endpoint)
+		}
+	}
+}
+
+func TestEncodeCustomValues(t *testing.T) {
+	rs := &ResultSet{
+		Name:    "Players",
+		Headers: []string{"POSITION", "BACKUP_POSITION", "MIN", "SEASON_MIN", "HEIGHT", "BIRTHDATE", "LAST_GAME_DATE", "DLEAGUE_FLAG", "SCORE", "HOME_RECORD"},
+		RowSet: [][]interface{}{
+			{"Guard", "Forward", "34:12", 2045.5, "6-3", "1988-10-04T00:00:00", "APR 13, 2015", "Y", "94 - 97", "30-11"},
+			{"Center", nil, "", nil, "", "", nil, "N", nil, ""},
+		},
+	}
+	var resp struct {
+		Players []*testPlayerDetails `nbagame:"Players"`
+	}
+	if err := rs.Decode(&resp.Players); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := Encode(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var encoded Response
+	if err := json.Unmarshal(body, &encoded); err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]interface{}{
+		{"guard", "forward", "34:12", "2045:30", "6-3", "1988-10-04T00:00:00", "2015-04-13T00:00:00", "Y", "94 - 97", "30-11"},
+		{"center", nil, "0:00", "0:00", "0-0", nil, nil, "N", nil, "0-0"},
+	}
+	if players := encoded.ResultSetByName("Players"); players == nil || !reflect.DeepEqual(players.Headers, rs.Headers) || !reflect.DeepEqual(players.RowSet, expected) {
+		t.Errorf("unexpected encoding: %s", body)
+	}
+
+	var roundTripped struct {
+		Players []*testPlayerDetails `nbagame:"Players"`
+	}
+	if err := encoded.Decode(&roundTripped); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp, roundTripped) {
+		t.Errorf("expected %+v, got %+v", resp.Players[0], roundTripped.Players[0])
+	}
+}
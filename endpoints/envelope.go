@@ -0,0 +1,200 @@
+package endpoints
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// Most endpoints respond with a list of "resultSets", but the envelope
+// varies:
+//
+//	{"resultSets": [{"name": ..., "headers": [...], "rowSet": [...]}, ...]}
+//	{"resultSet": {"name": ..., "headers": [...], "rowSet": [...]}}
+//	{"resultSets": {"name": ..., "headers": [...], "rowSet": [...]}}
+//
+// The newer v3 endpoints instead respond with plain nested JSON, ex.
+//
+//	{"boxScoreTraditional": {"gameId": ..., "homeTeam": {"players": [...]}}}
+//
+// which is flattened into result sets: see nestedResultSets.
+
+// UnmarshalJSON decodes a response in any of the envelopes stats.nba.com
+// uses.
+func (r *Response) UnmarshalJSON(body []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return err
+	}
+
+	*r = Response{}
+	nested := make(map[string]interface{})
+	for key, value := range members {
+		var err error
+		switch key {
+		case "resource":
+			err = json.Unmarshal(value, &r.Resource)
+		case "parameters":
+			err = json.Unmarshal(value, &r.Parameters)
+		case "resultSets", "resultSet":
+			var resultSets []*ResultSet
+			resultSets, err = unmarshalResultSets(value)
+			r.ResultSets = append(r.ResultSets, resultSets...)
+		default:
+			var v interface{}
+			err = json.Unmarshal(value, &v)
+			nested[key] = v
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.ResultSets == nil {
+		r.ResultSets = nestedResultSets(nested)
+	}
+	return nil
+}
+
+// unmarshalResultSets decodes either a list of result sets or a single one.
+func unmarshalResultSets(value json.RawMessage) ([]*ResultSet, error) {
+	value = bytes.TrimSpace(value)
+	if len(value) > 0 && value[0] == '{' {
+		var resultSet ResultSet
+		if err := json.Unmarshal(value, &resultSet); err != nil {
+			return nil, err
+		}
+		return []*ResultSet{&resultSet}, nil
+	}
+	var resultSets []*ResultSet
+	return resultSets, json.Unmarshal(value, &resultSets)
+}
+
+// UnmarshalJSON decodes a result set, whose headers may be grouped.
+func (rs *ResultSet) UnmarshalJSON(body []byte) error {
+	var v struct {
+		Name    string           `json:"name"`
+		Headers resultSetHeaders `json:"headers"`
+		RowSet  [][]interface{}  `json:"rowSet"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return err
+	}
+	*rs = ResultSet{Name: v.Name, Headers: v.Headers, RowSet: v.RowSet}
+	return nil
+}
+
+// resultSetHeaders decodes a result set's headers. They're usually a list of
+// column names, but some endpoints group columns under headers that span
+// them, ex.
+//
+//	[{"name": "SHOT_CATEGORY", "columnSpan": 3, "columnNames": ["Restricted Area", ...]},
+//	 {"name": "columns", "columnSpan": 1, "columnNames": ["TEAM_ID", "TEAM_NAME", "FGM", ...]}]
+//
+// in which case the last group names the columns.
+type resultSetHeaders []string
+
+func (h *resultSetHeaders) UnmarshalJSON(body []byte) error {
+	var names []string
+	if err := json.Unmarshal(body, &names); err == nil {
+		*h = names
+		return nil
+	}
+
+	var groups []struct {
+		Name        string   `json:"name"`
+		ColumnNames []string `json:"columnNames"`
+	}
+	if err := json.Unmarshal(body, &groups); err != nil {
+		return err
+	}
+	*h = nil
+	if len(groups) > 0 {
+		*h = groups[len(groups)-1].ColumnNames
+	}
+	return nil
+}
+
+// nestedResultSets flattens the plain nested JSON of a v3 response into
+// result sets. Every object, and every list of objects, becomes a result set
+// named by its dotted path, ex. "boxScoreTraditional.homeTeam.players". Its
+// headers are the keys of the objects' values, including those of nested
+// objects by their dotted path, ex. "statistics.points". Lists within lists
+// of objects are left out.
+func nestedResultSets(nested map[string]interface{}) []*ResultSet {
+	var resultSets []*ResultSet
+	var walk func(path string, value interface{})
+	walk = func(path string, value interface{}) {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			resultSets = append(resultSets, objectsResultSet(path, []map[string]interface{}{value}))
+			for _, key := range sortedKeys(value) {
+				walk(path+"."+key, value[key])
+			}
+		case []interface{}:
+			objects := make([]map[string]interface{}, 0, len(value))
+			for _, element := range value {
+				object, ok := element.(map[string]interface{})
+				if !ok {
+					return
+				}
+				objects = append(objects, object)
+			}
+			resultSets = append(resultSets, objectsResultSet(path, objects))
+		}
+	}
+	for _, key := range sortedKeys(nested) {
+		walk(key, nested[key])
+	}
+	return resultSets
+}
+
+// objectsResultSet returns a result set with a row for each object.
+func objectsResultSet(name string, objects []map[string]interface{}) *ResultSet {
+	rs := &ResultSet{Name: name, RowSet: make([][]interface{}, 0, len(objects))}
+	flattened := make([]map[string]interface{}, len(objects))
+	seen := make(map[string]bool)
+	for i, object := range objects {
+		flattened[i] = make(map[string]interface{})
+		flattenObject("", object, flattened[i])
+		for header := range flattened[i] {
+			if !seen[header] {
+				seen[header] = true
+				rs.Headers = append(rs.Headers, header)
+			}
+		}
+	}
+	sort.Strings(rs.Headers)
+
+	for _, values := range flattened {
+		row := make([]interface{}, len(rs.Headers))
+		for i, header := range rs.Headers {
+			row[i] = values[header]
+		}
+		rs.RowSet = append(rs.RowSet, row)
+	}
+	return rs
+}
+
+// flattenObject adds the values of an object to values, keyed by their
+// dotted path after prefix. Lists are left out.
+func flattenObject(prefix string, object map[string]interface{}, values map[string]interface{}) {
+	for key, value := range object {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			flattenObject(prefix+key+".", value, values)
+		case []interface{}:
+		default:
+			values[prefix+key] = value
+		}
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
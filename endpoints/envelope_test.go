@@ -0,0 +1,121 @@
+package endpoints
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testLeaderResponse struct {
+	Leaders []*struct {
+		PlayerID int     `nbagame:"PLAYER_ID"`
+		Player   string  `nbagame:"PLAYER"`
+		Points   float64 `nbagame:"PTS"`
+	} `nbagame:"LeagueLeaders"`
+}
+
+type testShotLocationsResponse struct {
+	ShotLocations []*struct {
+		TeamID   int    `nbagame:"TEAM_ID"`
+		TeamName string `nbagame:"TEAM_NAME"`
+	} `nbagame:"ShotLocations"`
+}
+
+type testBoxScoreV3Response struct {
+	Game []*struct {
+		GameID     string `nbagame:"gameId"`
+		HomeTeamID int    `nbagame:"homeTeamId"`
+	} `nbagame:"boxScoreTraditional"`
+	HomeTeam []*struct {
+		TeamID int `nbagame:"teamId"`
+		Points int `nbagame:"statistics.points"`
+	} `nbagame:"boxScoreTraditional.homeTeam"`
+	HomePlayers []*struct {
+		PersonID      int    `nbagame:"personId"`
+		FamilyName    string `nbagame:"familyName"`
+		SecondsPlayed int    `nbagame:"statistics.minutes,seconds"`
+		Points        int    `nbagame:"statistics.points"`
+	} `nbagame:"boxScoreTraditional.homeTeam.players"`
+}
+
+// decodeBothWays decodes body with NewResponse and Decode, and by
+// streaming, checking that they agree.
+func decodeBothWays(t *testing.T, body string, v interface{}) {
+	t.Helper()
+	resp, err := NewResponse([]byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resp.Decode(v); err != nil {
+		t.Fatal(err)
+	}
+
+	streamed := reflect.New(reflect.TypeOf(v).Elem()).Interface()
+	if _, _, err := streamResponse([]byte(body), streamed, DecodeDefault); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(v, streamed) {
+		t.Errorf("streamed response doesn't match: %+v", streamed)
+	}
+}
+
+func TestSingleResultSet(t *testing.T) {
+	body := `{"resource": "leagueleaders", "parameters": {"LeagueID": "00"},
+		"resultSet": {"name": "LeagueLeaders", "headers": ["PLAYER_ID", "PLAYER", "PTS"],
+		"rowSet": [[201935, "James Harden", 29.0], [201566, "Russell Westbrook", 28.1]]}}`
+
+	var resp testLeaderResponse
+	decodeBothWays(t, body, &resp)
+	if len(resp.Leaders) != 2 || resp.Leaders[1].Player != "Russell Westbrook" || resp.Leaders[0].Points != 29 {
+		t.Errorf("unexpected leaders: %+v", resp.Leaders)
+	}
+}
+
+func TestNestedResultSetWithHeaderGroups(t *testing.T) {
+	body := `{"resource": "leaguedashteamshotlocations", "parameters": {},
+		"resultSets": {"name": "ShotLocations", "headers": [
+			{"name": "SHOT_CATEGORY", "columnSpan": 3, "columnsToSkip": 2, "columnNames": ["Restricted Area"]},
+			{"name": "columns", "columnSpan": 1, "columnNames": ["TEAM_ID", "TEAM_NAME", "FGM", "FGA", "FG_PCT"]}],
+		"rowSet": [[1610612737, "Atlanta Hawks", 1204.0, 1956.0, 0.616]]}}`
+
+	var resp testShotLocationsResponse
+	decodeBothWays(t, body, &resp)
+	if len(resp.ShotLocations) != 1 || resp.ShotLocations[0].TeamName != "Atlanta Hawks" {
+		t.Errorf("unexpected shot locations: %+v", resp.ShotLocations)
+	}
+}
+
+func TestV3Response(t *testing.T) {
+	body := `{"meta": {"version": 1, "time": "2015-04-14 00:12:57"},
+		"boxScoreTraditional": {"gameId": "0021401185", "homeTeamId": 1610612737,
+			"homeTeam": {"teamId": 1610612737, "statistics": {"points": 95},
+				"players": [
+					{"personId": 201143, "familyName": "Horford", "statistics": {"minutes": "34:12", "points": 22}},
+					{"personId": 2594, "familyName": "Korver", "statistics": {"minutes": "", "points": 0}}
+				]}}}`
+
+	var resp testBoxScoreV3Response
+	decodeBothWays(t, body, &resp)
+	if len(resp.Game) != 1 || resp.Game[0].GameID != "0021401185" || resp.Game[0].HomeTeamID != 1610612737 {
+		t.Errorf("unexpected game: %+v", resp.Game)
+	}
+	if len(resp.HomeTeam) != 1 || resp.HomeTeam[0].Points != 95 {
+		t.Errorf("unexpected home team: %+v", resp.HomeTeam)
+	}
+	if len(resp.HomePlayers) != 2 || resp.HomePlayers[0].SecondsPlayed != 34*60+12 || resp.HomePlayers[0].Points != 22 || resp.HomePlayers[1].FamilyName != "Korver" {
+		t.Errorf("unexpected players: %+v", resp.HomePlayers)
+	}
+}
+
+func TestNestedResultSetNames(t *testing.T) {
+	resp, err := NewResponse([]byte(`{"scoreboard": {"gameDate": "2015-04-13", "games": []}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	view := ResponseView{response: resp}
+	if names := view.ResultSetNames(); !reflect.DeepEqual(names, []string{"scoreboard", "scoreboard.games"}) {
+		t.Errorf("unexpected result sets: %v", names)
+	}
+	if !reflect.DeepEqual(resp.ResultSets[0].Headers, []string{"gameDate"}) {
+		t.Errorf("unexpected headers: %v", resp.ResultSets[0].Headers)
+	}
+}
@@ -0,0 +1,106 @@
+package endpoints
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+	"unicode/utf8"
+)
+
+// bodySnippetLength is the maximum number of bytes of a response body kept
+// by a RequestError.
+const bodySnippetLength = 512
+
+var (
+	// ErrNotFound matches errors for requests about something that doesn't
+	// exist, ex. a game ID that was never played.
+	ErrNotFound = errors.New("nbagame: not found")
+	// ErrRateLimited matches errors for requests that stats.nba.com refused
+	// because it's throttling us.
+	ErrRateLimited = errors.New("nbagame: rate limited")
+	// ErrSchemaMismatch matches errors for responses that aren't formatted
+	// as expected, ex. a result set missing a header.
+	ErrSchemaMismatch = errors.New("nbagame: response doesn't match the expected schema")
+)
+
+// RequestError is returned by Requester.Request when a request to an
+// endpoint fails. Use errors.Is with ErrNotFound, ErrRateLimited and
+// ErrSchemaMismatch to tell the common failures apart.
+type RequestError struct {
+	Endpoint string
+	Params   url.Values
+	// StatusCode and Status describe the HTTP response. StatusCode is zero
+	// if no response was received.
+	StatusCode int
+	Status     string
+	// Body is the start of the response body, if there was one.
+	Body string
+	// Retryable reports whether the failure is transient, so that the
+	// request may succeed if sent again later.
+	Retryable bool
+	// RetryAfter is the delay the server asked for before retrying, if it
+	// sent a Retry-After header.
+	RetryAfter time.Duration
+	// Err is the underlying error, if the request didn't fail because of
+	// its HTTP status.
+	Err error
+}
+
+func (e *RequestError) Error() string {
+	request := cacheKey(e.Endpoint, e.Params)
+	if e.Err != nil {
+		return fmt.Sprintf("request to endpoint `%s` failed: %s", request, e.Err)
+	}
+	return fmt.Sprintf("endpoint `%s` returned status `%s`", request, e.Status)
+}
+
+// Unwrap returns the underlying error.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches ErrNotFound or ErrRateLimited,
+// judging by its HTTP status.
+func (e *RequestError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return isRateLimitStatus(e.StatusCode)
+	}
+	return false
+}
+
+// IsRetryable reports whether err is a RequestError for a transient
+// failure.
+func IsRetryable(err error) bool {
+	var reqErr *RequestError
+	return errors.As(err, &reqErr) && reqErr.Retryable
+}
+
+// requestError returns err as a RequestError for a request to the endpoint
+// with the given parameters.
+func requestError(endpoint string, params url.Values, err error) error {
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) {
+		reqErr = &RequestError{Err: err}
+	}
+	reqErr.Endpoint = endpoint
+	reqErr.Params = params
+	return reqErr
+}
+
+// snippet returns the start of a response body.
+func snippet(body []byte) string {
+	if len(body) <= bodySnippetLength {
+		return string(body)
+	}
+	body = body[:bodySnippetLength]
+	// Don't cut a multi-byte character in half.
+	for i := 0; i < utf8.UTFMax && !utf8.Valid(body); i++ {
+		body = body[:len(body)-1]
+	}
+	return string(body)
+}
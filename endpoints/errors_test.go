@@ -0,0 +1,80 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestErrors(t *testing.T) {
+	tests := []struct {
+		status    int
+		body      string
+		notFound  bool
+		limited   bool
+		schema    bool
+		retryable bool
+	}{
+		{status: http.StatusNotFound, body: "Not Found", notFound: true},
+		{status: http.StatusTooManyRequests, body: "slow down", limited: true, retryable: true},
+		{status: http.StatusServiceUnavailable, limited: true, retryable: true},
+		{status: http.StatusBadGateway, retryable: true},
+		{status: http.StatusBadRequest, body: "GameID is required"},
+		{status: http.StatusOK, body: "<html>Access Denied</html>", schema: true},
+		{status: http.StatusOK, body: `{"resultSets": [{"name": "GameSummary",
+			"headers": ["GAME_ID"], "rowSet": [["0021401185"]]}]}`, schema: true},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.status)
+			w.Write([]byte(test.body))
+		}))
+		requester := Requester{Domain: strings.TrimPrefix(server.URL, "http://")}
+
+		var resp testGameStatusResponse
+		err := requester.Request(context.Background(), "boxscoresummaryv2", BoxScoreSummaryParams{GameID: "0021401185"}, &resp)
+		server.Close()
+
+		var reqErr *RequestError
+		if !errors.As(err, &reqErr) {
+			t.Errorf("%v: expected a RequestError, got %#v", test.status, err)
+			continue
+		}
+		if reqErr.Endpoint != "boxscoresummaryv2" || reqErr.Params.Get("GameID") != "0021401185" {
+			t.Errorf("%v: expected the request to be described, got %+v", test.status, reqErr)
+		}
+		if reqErr.StatusCode != test.status || !strings.HasPrefix(test.body, reqErr.Body) {
+			t.Errorf("%v: unexpected status or body: %+v", test.status, reqErr)
+		}
+		if errors.Is(err, ErrNotFound) != test.notFound {
+			t.Errorf("%v: expected errors.Is(err, ErrNotFound) = %v", test.status, test.notFound)
+		}
+		if errors.Is(err, ErrRateLimited) != test.limited {
+			t.Errorf("%v: expected errors.Is(err, ErrRateLimited) = %v", test.status, test.limited)
+		}
+		if errors.Is(err, ErrSchemaMismatch) != test.schema {
+			t.Errorf("%v: expected errors.Is(err, ErrSchemaMismatch) = %v, got %v", test.status, test.schema, err)
+		}
+		if IsRetryable(err) != test.retryable {
+			t.Errorf("%v: expected IsRetryable(err) = %v", test.status, test.retryable)
+		}
+	}
+}
+
+func TestRequestErrorBodySnippet(t *testing.T) {
+	body := strings.Repeat("é", bodySnippetLength)
+	if s := snippet([]byte(body)); len(s) > bodySnippetLength || !strings.HasPrefix(body, s) {
+		t.Errorf("expected a prefix of at most %v bytes, got %v bytes", bodySnippetLength, len(s))
+	}
+}
+
+func TestBoxScoreSummaryNotFound(t *testing.T) {
+	var resp BoxScoreSummaryResponse
+	if _, err := resp.ToData(); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected an empty game summary to be not found, got %v", err)
+	}
+}
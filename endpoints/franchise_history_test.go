@@ -0,0 +1,37 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFranchiseHistory(t *testing.T) {
+	var resp FranchiseHistoryResponse
+	if err := testRequester.Request(context.Background(), "franchisehistory", &FranchiseHistoryParams{
+		LeagueID: "00",
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	teams := resp.Present()
+	if len(teams) != 30 {
+		t.Fatalf("expected 30 current teams, got %v", len(teams))
+	}
+	seen := make(map[int]struct{})
+	for _, team := range teams {
+		if _, ok := seen[team.ID]; ok {
+			t.Errorf("team %v appears more than once", team.ID)
+		}
+		seen[team.ID] = struct{}{}
+	}
+
+	// The Hawks' history includes rows for St. Louis and Milwaukee, which
+	// follow the cumulative row for the franchise.
+	hawks := teams[0]
+	if hawks.ID != 1610612737 || hawks.City != "Atlanta" || hawks.Name != "Hawks" {
+		t.Errorf("expected the Atlanta Hawks, got %+v", hawks)
+	}
+	if hawks.Wins+hawks.Losses != hawks.Games {
+		t.Errorf("expected wins and losses to add up to games, got %+v", hawks)
+	}
+}
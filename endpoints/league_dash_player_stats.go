@@ -0,0 +1,181 @@
+package endpoints
+
+import (
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// LeagueDashPlayerStatsParams defines parameters for a leaguedashplayerstats
+// request.
+// http://stats.nba.com/stats/leaguedashplayerstats?DateFrom=&DateTo=&GameSegment=&LastNGames=0&LeagueID=00&Location=&MeasureType=Base&Month=0&OpponentTeamID=0&Outcome=&PaceAdjust=N&PerMode=PerGame&Period=0&PlusMinus=N&Rank=N&Season=2014-15&SeasonSegment=&SeasonType=Regular+Season&VsConference=&VsDivision=
+type LeagueDashPlayerStatsParams struct {
+	DateFrom       time.Time `json:"DateFrom"`
+	DateTo         time.Time `json:"DateTo"`
+	GameSegment    string    `json:"GameSegment"`
+	LastNGames     int       `json:"LastNGames"`
+	LeagueID       string    `json:"LeagueID"`
+	Location       string    `json:"Location"`
+	MeasureType    string    `json:"MeasureType"`
+	Month          int       `json:"Month"`
+	OpponentTeamID int       `json:"OpponentTeamID"`
+	Outcome        string    `json:"Outcome"`
+	PaceAdjust     bool      `json:"PaceAdjust"`
+	PerMode        string    `json:"PerMode"`
+	Period         int       `json:"Period"`
+	PlusMinus      bool      `json:"PlusMinus"`
+	Rank           bool      `json:"Rank"`
+	Season         string    `json:"Season"`
+	SeasonSegment  string    `json:"SeasonSegment"`
+	SeasonType     string    `json:"SeasonType"`
+	VsConference   string    `json:"VsConference"`
+	VsDivision     string    `json:"VsDivision"`
+}
+
+// LeagueDashPlayerStatsResponse represents the response returned by the
+// leaguedashplayerstats endpoint when requested with the 'Base' measure
+// type.
+type LeagueDashPlayerStatsResponse struct {
+	Players []*LeagueDashPlayerRow `nbagame:"LeagueDashPlayerStats"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (r *LeagueDashPlayerStatsResponse) ToData() []*data.LeaguePlayerStats {
+	players := make([]*data.LeaguePlayerStats, 0, len(r.Players))
+	for _, row := range r.Players {
+		players = append(players, row.ToLeaguePlayerStats())
+	}
+	return players
+}
+
+// LeagueDashPlayerAdvancedResponse represents the response returned by the
+// leaguedashplayerstats endpoint when requested with the 'Advanced' measure
+// type.
+type LeagueDashPlayerAdvancedResponse struct {
+	Players []*LeagueDashPlayerAdvancedRow `nbagame:"LeagueDashPlayerStats"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (r *LeagueDashPlayerAdvancedResponse) ToData() []*data.LeaguePlayerStats {
+	players := make([]*data.LeaguePlayerStats, 0, len(r.Players))
+	for _, row := range r.Players {
+		players = append(players, row.ToLeaguePlayerStats())
+	}
+	return players
+}
+
+// LeagueDashPlayer contains the columns identifying a player that are
+// returned by the 'leaguedashplayerstats' resource for every measure type.
+type LeagueDashPlayer struct {
+	PlayerID         int     `nbagame:"PLAYER_ID"`
+	PlayerName       string  `nbagame:"PLAYER_NAME"`
+	TeamID           int     `nbagame:"TEAM_ID"`
+	TeamAbbreviation string  `nbagame:"TEAM_ABBREVIATION"`
+	Age              float64 `nbagame:"AGE"`
+	GamesPlayed      int     `nbagame:"GP"`
+	Wins             int     `nbagame:"W"`
+	Losses           int     `nbagame:"L"`
+	WinPercentage    float64 `nbagame:"W_PCT"`
+}
+
+func (p *LeagueDashPlayer) toLeaguePlayerStats() *data.LeaguePlayerStats {
+	return &data.LeaguePlayerStats{
+		PlayerID:         p.PlayerID,
+		PlayerName:       p.PlayerName,
+		TeamID:           p.TeamID,
+		TeamAbbreviation: p.TeamAbbreviation,
+		Age:              p.Age,
+		GamesPlayed:      p.GamesPlayed,
+		Wins:             p.Wins,
+		Losses:           p.Losses,
+		WinPercentage:    p.WinPercentage,
+	}
+}
+
+// LeagueDashPlayerRow represents the schema returned for
+// 'LeagueDashPlayerStats' result sets with the 'Base' measure type,
+// returned from the 'leaguedashplayerstats' resource.
+type LeagueDashPlayerRow struct {
+	LeagueDashPlayer
+	DashStatLine
+}
+
+// ToLeaguePlayerStats converts this row into a LeaguePlayerStats data
+// struct.
+func (r *LeagueDashPlayerRow) ToLeaguePlayerStats() *data.LeaguePlayerStats {
+	stats := r.DashStatLine.ToAggregateStats(r.GamesPlayed)
+	player := r.LeagueDashPlayer.toLeaguePlayerStats()
+	player.Stats = &stats
+	player.PlusMinus = r.PlusMinus
+	return player
+}
+
+// LeagueDashPlayerAdvancedRow represents the schema returned for
+// 'LeagueDashPlayerStats' result sets with the 'Advanced' measure type,
+// returned from the 'leaguedashplayerstats' resource.
+type LeagueDashPlayerAdvancedRow struct {
+	LeagueDashPlayer
+	AdvancedStatLine
+}
+
+// ToLeaguePlayerStats converts this row into a LeaguePlayerStats data
+// struct.
+func (r *LeagueDashPlayerAdvancedRow) ToLeaguePlayerStats() *data.LeaguePlayerStats {
+	stats := r.AdvancedStatLine.ToAdvancedStats()
+	player := r.LeagueDashPlayer.toLeaguePlayerStats()
+	player.Advanced = &stats
+	return player
+}
+
+// DashStatLine contains the traditional stats returned by the league
+// dashboard resources. Depending on the PerMode requested, they're either
+// totals or averages.
+type DashStatLine struct {
+	Minutes                float64 `nbagame:"MIN"`
+	FieldGoalsMade         float64 `nbagame:"FGM"`
+	FieldGoalsAttempted    float64 `nbagame:"FGA"`
+	FieldGoalPercentage    float64 `nbagame:"FG_PCT"`
+	ThreePointersMade      float64 `nbagame:"FG3M"`
+	ThreePointersAttempted float64 `nbagame:"FG3A"`
+	ThreePointPercentage   float64 `nbagame:"FG3_PCT"`
+	FreeThrowsMade         float64 `nbagame:"FTM"`
+	FreeThrowsAttempted    float64 `nbagame:"FTA"`
+	FreeThrowPercentage    float64 `nbagame:"FT_PCT"`
+	OffensiveRebounds      float64 `nbagame:"OREB"`
+	DefensiveRebounds      float64 `nbagame:"DREB"`
+	Rebounds               float64 `nbagame:"REB"`
+	Assists                float64 `nbagame:"AST"`
+	Turnovers              float64 `nbagame:"TOV"`
+	Steals                 float64 `nbagame:"STL"`
+	Blocks                 float64 `nbagame:"BLK"`
+	PersonalFouls          float64 `nbagame:"PF"`
+	Points                 float64 `nbagame:"PTS"`
+	PlusMinus              float64 `nbagame:"PLUS_MINUS"`
+}
+
+// ToAggregateStats converts a DashStatLine into a data AggregateStats
+// struct. The dashboards don't report games started.
+func (sl *DashStatLine) ToAggregateStats(gamesPlayed int) data.AggregateStats {
+	return data.AggregateStats{
+		GamesPlayed:            gamesPlayed,
+		Minutes:                sl.Minutes,
+		FieldGoalsMade:         sl.FieldGoalsMade,
+		FieldGoalsAttempted:    sl.FieldGoalsAttempted,
+		FieldGoalPercentage:    sl.FieldGoalPercentage,
+		ThreePointersMade:      sl.ThreePointersMade,
+		ThreePointersAttempted: sl.ThreePointersAttempted,
+		ThreePointPercentage:   sl.ThreePointPercentage,
+		FreeThrowsMade:         sl.FreeThrowsMade,
+		FreeThrowsAttempted:    sl.FreeThrowsAttempted,
+		FreeThrowPercentage:    sl.FreeThrowPercentage,
+		OffensiveRebounds:      sl.OffensiveRebounds,
+		DefensiveRebounds:      sl.DefensiveRebounds,
+		Rebounds:               sl.Rebounds,
+		Assists:                sl.Assists,
+		Steals:                 sl.Steals,
+		Blocks:                 sl.Blocks,
+		Turnovers:              sl.Turnovers,
+		PersonalFouls:          sl.PersonalFouls,
+		Points:                 sl.Points,
+	}
+}
@@ -0,0 +1,59 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func leagueDashParams(measureType string) *LeagueDashPlayerStatsParams {
+	return &LeagueDashPlayerStatsParams{
+		LeagueID:    "00",
+		MeasureType: measureType,
+		PerMode:     "PerGame",
+		Season:      "2014-15",
+		SeasonType:  "Regular Season",
+	}
+}
+
+func TestLeagueDashPlayerStats(t *testing.T) {
+	var resp LeagueDashPlayerStatsResponse
+	if err := testRequester.Request(context.Background(), "leaguedashplayerstats", leagueDashParams("Base"), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	players := resp.ToData()
+	if len(players) == 0 {
+		t.Fatal("expected players")
+	}
+	for _, player := range players {
+		if player.Stats == nil || player.Advanced != nil {
+			t.Fatalf("expected only traditional stats, got %+v", player)
+		}
+		if player.Wins+player.Losses != player.GamesPlayed || player.Stats.GamesPlayed != player.GamesPlayed {
+			t.Errorf("expected wins and losses to add up to games played, got %+v", player)
+		}
+		if player.Stats.Rebounds < player.Stats.DefensiveRebounds {
+			t.Errorf("expected rebounds to include defensive rebounds, got %+v", player.Stats)
+		}
+	}
+}
+
+func TestLeagueDashPlayerAdvancedStats(t *testing.T) {
+	var resp LeagueDashPlayerAdvancedResponse
+	if err := testRequester.Request(context.Background(), "leaguedashplayerstats", leagueDashParams("Advanced"), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	players := resp.ToData()
+	if len(players) == 0 {
+		t.Fatal("expected players")
+	}
+	for _, player := range players {
+		if player.Advanced == nil || player.Stats != nil {
+			t.Fatalf("expected only advanced stats, got %+v", player)
+		}
+		if player.Advanced.SecondsPlayed == 0 || player.Advanced.UsagePercentage == 0 {
+			t.Errorf("expected minutes and usage, got %+v", player.Advanced)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// LeagueDashTeamStatsParams defines parameters for a leaguedashteamstats
+// request. It takes the same parameters as leaguedashplayerstats.
+// http://stats.nba.com/stats/leaguedashteamstats?DateFrom=&DateTo=&GameSegment=&LastNGames=0&LeagueID=00&Location=&MeasureType=Base&Month=0&OpponentTeamID=0&Outcome=&PaceAdjust=N&PerMode=PerGame&Period=0&PlusMinus=N&Rank=N&Season=2014-15&SeasonSegment=&SeasonType=Regular+Season&VsConference=&VsDivision=
+type LeagueDashTeamStatsParams LeagueDashPlayerStatsParams
+
+// LeagueDashTeamStatsResponse represents the response returned by the
+// leaguedashteamstats endpoint when requested with the 'Base' measure type.
+type LeagueDashTeamStatsResponse struct {
+	Teams []*LeagueDashTeamRow `nbagame:"LeagueDashTeamStats"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (r *LeagueDashTeamStatsResponse) ToData() []*data.LeagueTeamStats {
+	teams := make([]*data.LeagueTeamStats, 0, len(r.Teams))
+	for _, row := range r.Teams {
+		teams = append(teams, row.ToLeagueTeamStats())
+	}
+	return teams
+}
+
+// LeagueDashTeamAdvancedResponse represents the response returned by the
+// leaguedashteamstats endpoint when requested with the 'Advanced' measure
+// type.
+type LeagueDashTeamAdvancedResponse struct {
+	Teams []*LeagueDashTeamAdvancedRow `nbagame:"LeagueDashTeamStats"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (r *LeagueDashTeamAdvancedResponse) ToData() []*data.LeagueTeamStats {
+	teams := make([]*data.LeagueTeamStats, 0, len(r.Teams))
+	for _, row := range r.Teams {
+		teams = append(teams, row.ToLeagueTeamStats())
+	}
+	return teams
+}
+
+// LeagueDashTeam contains the columns identifying a team that are returned
+// by the 'leaguedashteamstats' resource for every measure type.
+type LeagueDashTeam struct {
+	TeamID        int     `nbagame:"TEAM_ID"`
+	TeamName      string  `nbagame:"TEAM_NAME"`
+	GamesPlayed   int     `nbagame:"GP"`
+	Wins          int     `nbagame:"W"`
+	Losses        int     `nbagame:"L"`
+	WinPercentage float64 `nbagame:"W_PCT"`
+}
+
+func (t *LeagueDashTeam) toLeagueTeamStats() *data.LeagueTeamStats {
+	return &data.LeagueTeamStats{
+		TeamID:        t.TeamID,
+		TeamName:      t.TeamName,
+		GamesPlayed:   t.GamesPlayed,
+		Wins:          t.Wins,
+		Losses:        t.Losses,
+		WinPercentage: t.WinPercentage,
+	}
+}
+
+// LeagueDashTeamRow represents the schema returned for 'LeagueDashTeamStats'
+// result sets with the 'Base' measure type, returned from the
+// 'leaguedashteamstats' resource.
+type LeagueDashTeamRow struct {
+	LeagueDashTeam
+	DashStatLine
+}
+
+// ToLeagueTeamStats converts this row into a LeagueTeamStats data struct.
+func (r *LeagueDashTeamRow) ToLeagueTeamStats() *data.LeagueTeamStats {
+	stats := r.DashStatLine.ToAggregateStats(r.GamesPlayed)
+	team := r.LeagueDashTeam.toLeagueTeamStats()
+	team.Stats = &stats
+	team.PlusMinus = r.PlusMinus
+	return team
+}
+
+// LeagueDashTeamAdvancedRow represents the schema returned for
+// 'LeagueDashTeamStats' result sets with the 'Advanced' measure type,
+// returned from the 'leaguedashteamstats' resource.
+type LeagueDashTeamAdvancedRow struct {
+	LeagueDashTeam
+	AdvancedStatLine
+}
+
+// ToLeagueTeamStats converts this row into a LeagueTeamStats data struct.
+func (r *LeagueDashTeamAdvancedRow) ToLeagueTeamStats() *data.LeagueTeamStats {
+	stats := r.AdvancedStatLine.ToAdvancedStats()
+	team := r.LeagueDashTeam.toLeagueTeamStats()
+	team.Advanced = &stats
+	return team
+}
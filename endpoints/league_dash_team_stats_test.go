@@ -0,0 +1,50 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLeagueDashTeamStats(t *testing.T) {
+	var resp LeagueDashTeamStatsResponse
+	params := LeagueDashTeamStatsParams(*leagueDashParams("Base"))
+	if err := testRequester.Request(context.Background(), "leaguedashteamstats", &params, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	teams := resp.ToData()
+	if len(teams) != 30 {
+		t.Fatalf("expected 30 teams, got %v", len(teams))
+	}
+	for _, team := range teams {
+		if team.Wins+team.Losses != team.GamesPlayed || team.Stats == nil || team.Stats.Minutes != 48 {
+			t.Errorf("expected per game stats over the team's games, got %+v", team)
+		}
+	}
+
+	hawks := teams[0]
+	if hawks.TeamName != "Atlanta Hawks" || hawks.Wins != 60 || hawks.WinPercentage <= 0.5 || hawks.PlusMinus <= 0 {
+		t.Errorf("unexpected Hawks stats: %+v", hawks)
+	}
+}
+
+func TestLeagueDashTeamAdvancedStats(t *testing.T) {
+	var resp LeagueDashTeamAdvancedResponse
+	params := LeagueDashTeamStatsParams(*leagueDashParams("Advanced"))
+	if err := testRequester.Request(context.Background(), "leaguedashteamstats", &params, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	teams := resp.ToData()
+	if len(teams) != 30 {
+		t.Fatalf("expected 30 teams, got %v", len(teams))
+	}
+	for _, team := range teams {
+		if team.Advanced == nil || team.Advanced.SecondsPlayed != 48*60 {
+			t.Errorf("expected advanced stats over 48 minutes, got %+v", team)
+		}
+		if team.Advanced.Pace == 0 {
+			t.Errorf("expected %v to have a pace", team.TeamName)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// LeagueLeadersParams defines parameters for a leagueleaders request.
+// http://stats.nba.com/stats/leagueleaders?LeagueID=00&PerMode=PerGame&Scope=S&Season=2014-15&SeasonType=Regular+Season&StatCategory=PTS
+type LeagueLeadersParams struct {
+	LeagueID     string `json:"LeagueID"`
+	PerMode      string `json:"PerMode"`
+	Scope        string `json:"Scope"`
+	Season       string `json:"Season"`
+	SeasonType   string `json:"SeasonType"`
+	StatCategory string `json:"StatCategory"`
+}
+
+// LeagueLeadersResponse represents the response returned by the leagueleaders
+// endpoint. Unlike most endpoints, it responds with a single 'resultSet'
+// rather than a list of 'resultSets'.
+type LeagueLeadersResponse struct {
+	Leaders []*LeagueLeadersRow `nbagame:"LeagueLeaders"`
+}
+
+// ToData returns a nbagame.data representation of this response, with the
+// leaders ranked by category.
+func (r *LeagueLeadersResponse) ToData(category data.StatCategory) []*data.LeaderEntry {
+	leaders := make([]*data.LeaderEntry, 0, len(r.Leaders))
+	for _, row := range r.Leaders {
+		leaders = append(leaders, row.ToLeaderEntry(category))
+	}
+	return leaders
+}
+
+// LeagueLeadersRow represents the schema returned for 'LeagueLeaders' result
+// sets, returned from the 'leagueleaders' resource.
+type LeagueLeadersRow struct {
+	PlayerID               int     `nbagame:"PLAYER_ID"`
+	Rank                   int     `nbagame:"RANK"`
+	PlayerName             string  `nbagame:"PLAYER"`
+	TeamAbbreviation       string  `nbagame:"TEAM"`
+	GamesPlayed            int     `nbagame:"GP"`
+	Minutes                float64 `nbagame:"MIN"`
+	FieldGoalsMade         float64 `nbagame:"FGM"`
+	FieldGoalsAttempted    float64 `nbagame:"FGA"`
+	FieldGoalPercentage    float64 `nbagame:"FG_PCT"`
+	ThreePointersMade      float64 `nbagame:"FG3M"`
+	ThreePointersAttempted float64 `nbagame:"FG3A"`
+	ThreePointPercentage   float64 `nbagame:"FG3_PCT"`
+	FreeThrowsMade         float64 `nbagame:"FTM"`
+	FreeThrowsAttempted    float64 `nbagame:"FTA"`
+	FreeThrowPercentage    float64 `nbagame:"FT_PCT"`
+	OffensiveRebounds      float64 `nbagame:"OREB"`
+	DefensiveRebounds      float64 `nbagame:"DREB"`
+	Rebounds               float64 `nbagame:"REB"`
+	Assists                float64 `nbagame:"AST"`
+	Steals                 float64 `nbagame:"STL"`
+	Blocks                 float64 `nbagame:"BLK"`
+	Turnovers              float64 `nbagame:"TOV"`
+	Points                 float64 `nbagame:"PTS"`
+	Efficiency             float64 `nbagame:"EFF"`
+}
+
+// ToLeaderEntry converts this row into a LeaderEntry data struct, ranked by
+// category.
+func (r *LeagueLeadersRow) ToLeaderEntry(category data.StatCategory) *data.LeaderEntry {
+	return &data.LeaderEntry{
+		Rank:             r.Rank,
+		PlayerID:         r.PlayerID,
+		PlayerName:       r.PlayerName,
+		TeamAbbreviation: r.TeamAbbreviation,
+		Category:         category,
+		Value:            r.value(category),
+		AggregateStats: data.AggregateStats{
+			GamesPlayed:            r.GamesPlayed,
+			Minutes:                r.Minutes,
+			FieldGoalsMade:         r.FieldGoalsMade,
+			FieldGoalsAttempted:    r.FieldGoalsAttempted,
+			FieldGoalPercentage:    r.FieldGoalPercentage,
+			ThreePointersMade:      r.ThreePointersMade,
+			ThreePointersAttempted: r.ThreePointersAttempted,
+			ThreePointPercentage:   r.ThreePointPercentage,
+			FreeThrowsMade:         r.FreeThrowsMade,
+			FreeThrowsAttempted:    r.FreeThrowsAttempted,
+			FreeThrowPercentage:    r.FreeThrowPercentage,
+			OffensiveRebounds:      r.OffensiveRebounds,
+			DefensiveRebounds:      r.DefensiveRebounds,
+			Rebounds:               r.Rebounds,
+			Assists:                r.Assists,
+			Steals:                 r.Steals,
+			Blocks:                 r.Blocks,
+			Turnovers:              r.Turnovers,
+			Points:                 r.Points,
+		},
+		Efficiency: r.Efficiency,
+	}
+}
+
+// value returns the row's value in the given stat category.
+func (r *LeagueLeadersRow) value(category data.StatCategory) float64 {
+	switch category {
+	case data.CategoryPoints:
+		return r.Points
+	case data.CategoryRebounds:
+		return r.Rebounds
+	case data.CategoryAssists:
+		return r.Assists
+	case data.CategorySteals:
+		return r.Steals
+	case data.CategoryBlocks:
+		return r.Blocks
+	case data.CategoryFieldGoalPercentage:
+		return r.FieldGoalPercentage
+	case data.CategoryThreePointPercentage:
+		return r.ThreePointPercentage
+	case data.CategoryFreeThrowPercentage:
+		return r.FreeThrowPercentage
+	case data.CategoryEfficiency:
+		return r.Efficiency
+	}
+	return 0
+}
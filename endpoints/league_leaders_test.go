@@ -0,0 +1,38 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestLeagueLeaders(t *testing.T) {
+	var resp LeagueLeadersResponse
+	if err := testRequester.Request(context.Background(), "leagueleaders", &LeagueLeadersParams{
+		LeagueID:     "00",
+		PerMode:      "PerGame",
+		Scope:        "S",
+		Season:       "2014-15",
+		SeasonType:   "Regular Season",
+		StatCategory: "PTS",
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	leaders := resp.ToData(data.CategoryPoints)
+	if len(leaders) == 0 {
+		t.Fatal("expected leaders")
+	}
+	for i, leader := range leaders {
+		if leader.Value != leader.Points {
+			t.Errorf("expected the value to be points, got %+v", leader)
+		}
+		if i > 0 && (leader.Rank < leaders[i-1].Rank || leader.Value > leaders[i-1].Value) {
+			t.Errorf("expected leaders to be ranked, got %+v after %+v", leader, leaders[i-1])
+		}
+	}
+	if leaders[0].Rank != 1 || leaders[0].PlayerID == 0 || leaders[0].PlayerName == "" || leaders[0].TeamAbbreviation == "" {
+		t.Errorf("expected the scoring leader to be ranked first, got %+v", leaders[0])
+	}
+}
@@ -0,0 +1,73 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// LeagueStandingsParams defines parameters for a LeagueStandings request.
+// http://stats.nba.com/stats/leaguestandingsv3?LeagueID=00&Season=2014-15&SeasonType=Regular+Season
+type LeagueStandingsParams struct {
+	LeagueID   string `json:"LeagueID"`
+	Season     string `json:"Season"`
+	SeasonType string `json:"SeasonType"`
+}
+
+// LeagueStandingsResponse is the type for all result sets returned by the
+// 'leaguestandingsv3' resource.
+type LeagueStandingsResponse struct {
+	Standings []*StandingsRow `nbagame:"Standings"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (resp *LeagueStandingsResponse) ToData() []*data.Standing {
+	standings := make([]*data.Standing, 0, len(resp.Standings))
+	for _, r := range resp.Standings {
+		standings = append(standings, r.ToStanding())
+	}
+	return standings
+}
+
+// StandingsRow represents the schema returned for 'Standings' result sets,
+// returned from the 'leaguestandingsv3' resource. The resource returns many
+// more splits, ex. by month and against each division, that aren't decoded.
+type StandingsRow struct {
+	LeagueID            string      `nbagame:"LeagueID"`
+	SeasonID            string      `nbagame:"SeasonID"`
+	TeamID              int         `nbagame:"TeamID"`
+	TeamCity            string      `nbagame:"TeamCity"`
+	TeamName            string      `nbagame:"TeamName"`
+	Conference          string      `nbagame:"Conference"`
+	ConferenceRecord    data.Record `nbagame:"ConferenceRecord,record"`
+	PlayoffRank         int         `nbagame:"PlayoffRank"`
+	ClinchIndicator     string      `nbagame:"ClinchIndicator"`
+	Division            string      `nbagame:"Division"`
+	DivisionRecord      data.Record `nbagame:"DivisionRecord,record"`
+	DivisionRank        int         `nbagame:"DivisionRank"`
+	Wins                int         `nbagame:"WINS"`
+	Losses              int         `nbagame:"LOSSES"`
+	WinPercentage       float64     `nbagame:"WinPCT"`
+	LeagueRank          int         `nbagame:"LeagueRank"`
+	Home                data.Record `nbagame:"HOME,record"`
+	Road                data.Record `nbagame:"ROAD,record"`
+	LastTen             data.Record `nbagame:"L10,record"`
+	CurrentStreak       int         `nbagame:"CurrentStreak"`
+	ConferenceGamesBack float64     `nbagame:"ConferenceGamesBack"`
+	DivisionGamesBack   float64     `nbagame:"DivisionGamesBack"`
+}
+
+// ToStanding converts this row into a Standing data struct.
+func (r *StandingsRow) ToStanding() *data.Standing {
+	return &data.Standing{
+		TeamID:         r.TeamID,
+		TeamCity:       r.TeamCity,
+		TeamName:       r.TeamName,
+		Conference:     r.Conference,
+		Wins:           r.Wins,
+		Losses:         r.Losses,
+		WinPercentage:  r.WinPercentage,
+		GamesBack:      r.ConferenceGamesBack,
+		ConferenceRank: r.PlayoffRank,
+		Streak:         r.CurrentStreak,
+		Home:           r.Home,
+		Road:           r.Road,
+		LastTen:        r.LastTen,
+	}
+}
@@ -0,0 +1,41 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLeagueStandings(t *testing.T) {
+	var resp LeagueStandingsResponse
+	if err := testRequester.Request(context.Background(), "leaguestandingsv3", &LeagueStandingsParams{
+		LeagueID:   "00",
+		Season:     "2014-15",
+		SeasonType: "Regular Season",
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	standings := resp.ToData()
+	if len(standings) != 30 {
+		t.Fatalf("expected 30 teams, got %v", len(standings))
+	}
+	for _, standing := range standings {
+		if standing.LastTen.Wins+standing.LastTen.Losses != 10 {
+			t.Errorf("expected a record over the last 10 games, got %v", standing.LastTen)
+		}
+		if standing.Streak == 0 {
+			t.Errorf("expected %v to have a streak", standing.TeamName)
+		}
+		if standing.Home.Wins+standing.Road.Wins != standing.Wins {
+			t.Errorf("expected home and road wins to add up, got %+v", standing)
+		}
+		if (standing.ConferenceRank == 1) != (standing.GamesBack == 0) {
+			t.Errorf("expected only the conference leader to be 0 games back, got %+v", standing)
+		}
+	}
+
+	hawks := standings[0]
+	if hawks.TeamName != "Hawks" || hawks.ConferenceRank != 1 || hawks.Wins != 60 || hawks.Home.String() != "30-11" {
+		t.Errorf("expected the Hawks to lead the East, got %+v", hawks)
+	}
+}
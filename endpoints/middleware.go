@@ -0,0 +1,118 @@
+package endpoints
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Call describes a request to an endpoint as it passes through a
+// Requester's middleware. Middleware may modify the Header before passing
+// the Call on; the remaining fields are filled in by the Requester as the
+// request is performed.
+type Call struct {
+	Endpoint string
+	Params   url.Values
+	// Header holds the headers sent with the request.
+	Header http.Header
+
+	// StatusCode is the HTTP status of the last attempt at the request, or
+	// zero if no response was received.
+	StatusCode int
+	// Attempts is the number of times the request was sent to the server.
+	Attempts int
+	// Cached reports whether the response was served from the Cache or
+	// replayed by the Recorder, rather than sent to the server.
+	Cached bool
+	// Bytes is the size of the response body.
+	Bytes int
+	// Response is a view of the response, or nil if none was parsed.
+	Response *ResponseView
+	// Drift describes how the response differs from the expected schema,
+	// or is nil if it matches. What's reported depends on the Requester's
+	// DecodeMode.
+	Drift *SchemaDrift
+
+	// dest is what the response is decoded into.
+	dest interface{}
+}
+
+// Handler performs a Call.
+type Handler func(ctx context.Context, call *Call) error
+
+// Middleware wraps a Handler with behavior of its own, ex. logging, metrics
+// or tracing. It should call next to perform the Call, unless it means to
+// short-circuit the request.
+type Middleware func(next Handler) Handler
+
+// ResponseView is a read-only view of a Response, for middleware to observe
+// what was received.
+type ResponseView struct {
+	response *Response
+}
+
+// Resource returns the name of the resource the response describes.
+func (v *ResponseView) Resource() string {
+	return v.response.Resource
+}
+
+// Parameters returns the parameters the server says it responded to.
+func (v *ResponseView) Parameters() map[string]interface{} {
+	params, _ := v.response.Parameters.(map[string]interface{})
+	copied := make(map[string]interface{}, len(params))
+	for name, value := range params {
+		copied[name] = value
+	}
+	return copied
+}
+
+// ResultSetNames returns the names of the response's result sets, in order.
+func (v *ResponseView) ResultSetNames() []string {
+	names := make([]string, len(v.response.ResultSets))
+	for i, resultSet := range v.response.ResultSets {
+		names[i] = resultSet.Name
+	}
+	return names
+}
+
+// Rows returns the number of rows in the named result set.
+func (v *ResponseView) Rows(name string) int {
+	resultSet := v.response.ResultSetByName(name)
+	if resultSet == nil {
+		return 0
+	}
+	return len(resultSet.RowSet) + resultSet.streamedRows
+}
+
+// LogRequests returns Middleware that logs every request to logger, with
+// its status, size, duration and error.
+func LogRequests(logger *log.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			start := time.Now()
+			err := next(ctx, call)
+			request := cacheKey(call.Endpoint, call.Params)
+			elapsed := time.Since(start)
+			switch {
+			case err != nil:
+				logger.Printf("%s: status %v after %v attempts in %v: %s", request, call.StatusCode, call.Attempts, elapsed, err)
+			case call.Cached:
+				logger.Printf("%s: cached, %v bytes in %v", request, call.Bytes, elapsed)
+			default:
+				logger.Printf("%s: status %v, %v bytes in %v", request, call.StatusCode, call.Bytes, elapsed)
+			}
+			return err
+		}
+	}
+}
+
+// chain wraps handler in the Requester's middleware, so that the first
+// middleware is the outermost.
+func (r *Requester) chain(handler Handler) Handler {
+	for i := len(r.Middleware) - 1; i >= 0; i-- {
+		handler = r.Middleware[i](handler)
+	}
+	return handler
+}
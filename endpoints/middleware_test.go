@@ -0,0 +1,144 @@
+package endpoints
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Trace") != "outer" || r.Header.Get("Referer") != NBAStatsReferer {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"resource": "boxscoresummaryv2", "parameters": {"GameID": "0021401185"},
+			"resultSets": [{"name": "GameSummary", "headers": ["GAME_ID", "GAME_STATUS_ID"],
+			"rowSet": [["0021401185", 3]]}, {"name": "LineScore", "headers": [], "rowSet": []}]}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var observed *Call
+	requester := Requester{
+		Domain: strings.TrimPrefix(server.URL, "http://"),
+		Middleware: []Middleware{
+			func(next Handler) Handler {
+				return func(ctx context.Context, call *Call) error {
+					order = append(order, "outer")
+					call.Header.Set("X-Trace", "outer")
+					err := next(ctx, call)
+					order = append(order, "outer done")
+					observed = call
+					return err
+				}
+			},
+			func(next Handler) Handler {
+				return func(ctx context.Context, call *Call) error {
+					order = append(order, "inner")
+					err := next(ctx, call)
+					order = append(order, "inner done")
+					return err
+				}
+			},
+		},
+	}
+
+	var resp testGameStatusResponse
+	if err := requester.Request(context.Background(), "boxscoresummaryv2", BoxScoreSummaryParams{GameID: "0021401185"}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GameSummary) != 1 {
+		t.Errorf("expected the response to be decoded, got %+v", resp)
+	}
+	if !reflect.DeepEqual(order, []string{"outer", "inner", "inner done", "outer done"}) {
+		t.Errorf("unexpected middleware order: %v", order)
+	}
+
+	if observed.Endpoint != "boxscoresummaryv2" || observed.Params.Get("GameID") != "0021401185" {
+		t.Errorf("unexpected call: %+v", observed)
+	}
+	if observed.StatusCode != http.StatusOK || observed.Attempts != 1 || observed.Cached || observed.Bytes == 0 {
+		t.Errorf("unexpected call: %+v", observed)
+	}
+	view := observed.Response
+	if view.Resource() != "boxscoresummaryv2" || view.Parameters()["GameID"] != "0021401185" {
+		t.Errorf("unexpected response: %v %v", view.Resource(), view.Parameters())
+	}
+	if names := view.ResultSetNames(); !reflect.DeepEqual(names, []string{"GameSummary", "LineScore"}) {
+		t.Errorf("unexpected result sets: %v", names)
+	}
+	if view.Rows("GameSummary") != 1 || view.Rows("LineScore") != 0 {
+		t.Errorf("unexpected row counts")
+	}
+}
+
+func TestMiddlewareShortCircuits(t *testing.T) {
+	errBlocked := errors.New("blocked")
+	requester := Requester{
+		Domain: "localhost:1",
+		Middleware: []Middleware{
+			func(next Handler) Handler {
+				return func(ctx context.Context, call *Call) error {
+					return errBlocked
+				}
+			},
+		},
+	}
+
+	var resp testGameStatusResponse
+	err := requester.Request(context.Background(), "boxscoresummaryv2", BoxScoreSummaryParams{GameID: "0021401185"}, &resp)
+	if err != errBlocked {
+		t.Errorf("expected the middleware's error, got %v", err)
+	}
+}
+
+func TestLogRequests(t *testing.T) {
+	var buf bytes.Buffer
+	requester := *testRequester
+	requester.Middleware = []Middleware{LogRequests(log.New(&buf, "", 0))}
+
+	var resp FranchiseHistoryResponse
+	if err := requester.Request(context.Background(), "franchisehistory", &FranchiseHistoryParams{LeagueID: "00"}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "franchisehistory?LeagueID=00: cached") {
+		t.Errorf("unexpected log: %q", buf.String())
+	}
+}
+
+func TestCallRecordsDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"resultSets": [{"name": "GameSummary",
+			"headers": ["GAME_ID"], "rowSet": [["0021401185"]]}]}`))
+	}))
+	defer server.Close()
+
+	var observed *Call
+	requester := Requester{
+		Domain:     strings.TrimPrefix(server.URL, "http://"),
+		DecodeMode: DecodeLenient,
+		Middleware: []Middleware{
+			func(next Handler) Handler {
+				return func(ctx context.Context, call *Call) error {
+					observed = call
+					return next(ctx, call)
+				}
+			},
+		},
+	}
+
+	var resp testGameStatusResponse
+	if err := requester.Request(context.Background(), "boxscoresummaryv2", BoxScoreSummaryParams{GameID: "0021401185"}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if observed.Drift == nil || observed.Drift.Error() != "schema drift: GameSummary: missing headers [GAME_STATUS_ID]" {
+		t.Errorf("expected drift to be recorded, got %v", observed.Drift)
+	}
+}
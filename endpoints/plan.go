@@ -0,0 +1,228 @@
+package endpoints
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// decodePlans caches a *decodePlan for each row struct type.
+var decodePlans sync.Map
+
+// decodePlan describes how to decode result set rows into a struct type,
+// and encode them back, so that its fields and tags are only inspected
+// once.
+type decodePlan struct {
+	fields []fieldPlan
+	// headers are the headers of the fields, in order.
+	headers []string
+	err     error
+}
+
+// fieldPlan describes how to decode a column into one of a struct's fields,
+// and encode it back.
+type fieldPlan struct {
+	index  []int
+	header string
+	set    setter
+	get    getter
+}
+
+// setter sets a field from a non-nil cell.
+type setter func(fieldValue reflect.Value, cell interface{}) error
+
+// planFor returns the decode plan for rows of struct type t.
+func planFor(t reflect.Type) (*decodePlan, error) {
+	if plan, ok := decodePlans.Load(t); ok {
+		return plan.(*decodePlan), plan.(*decodePlan).err
+	}
+	plan := &decodePlan{}
+	plan.err = plan.compile(t, nil)
+	actual, _ := decodePlans.LoadOrStore(t, plan)
+	return actual.(*decodePlan), plan.err
+}
+
+func (p *decodePlan) compile(t reflect.Type, index []int) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		tag := field.Tag.Get(tagKey)
+		if tag == "" {
+			if field.Type.Kind() == reflect.Struct {
+				// Decode into any embedded structs too
+				if err := p.compile(field.Type, fieldIndex); err != nil {
+					return err
+				}
+			}
+			// Skip any other fields that don't have a tag.
+			continue
+		}
+		header, option := parseTag(tag)
+
+		set, err := setterFor(field.Type, header, option)
+		if err != nil {
+			return err
+		}
+		p.fields = append(p.fields, fieldPlan{
+			index:  fieldIndex,
+			header: header,
+			set:    set,
+			get:    getterFor(field.Type, option),
+		})
+		p.headers = append(p.headers, header)
+	}
+	return nil
+}
+
+// columns returns the index of each field's column in a result set with
+// the given headers, or -1 if the column is missing.
+func (p *decodePlan) columns(headers map[string]int) []int {
+	columns := make([]int, len(p.fields))
+	for i, field := range p.fields {
+		column, ok := headers[field.header]
+		if !ok {
+			column = -1
+		}
+		columns[i] = column
+	}
+	return columns
+}
+
+// populate sets the fields of v from a row, given the plan's columns.
+func (p *decodePlan) populate(v reflect.Value, row []interface{}, columns []int) error {
+	for i, field := range p.fields {
+		column := columns[i]
+		if column < 0 {
+			// The header is missing, and the decode mode allows it.
+			continue
+		}
+		cell := row[column]
+		if cell == nil {
+			continue
+		}
+		if err := field.set(v.FieldByIndex(field.index), cell); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setterFor returns the setter for a field of type t decoded from the
+// column with the given header, according to its tag option.
+func setterFor(t reflect.Type, header, option string) (setter, error) {
+	switch {
+	case reflect.PtrTo(t).Implements(resultSetValueUnmarshalerType):
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			if err := fieldValue.Addr().Interface().(ResultSetValueUnmarshaler).UnmarshalResultSetValue(cell); err != nil {
+				return ErrBadResponse(fmt.Sprintf("cannot unmarshal %s: %s", header, err))
+			}
+			return nil
+		}, nil
+	case t.Kind() == reflect.Ptr && t.Implements(resultSetValueUnmarshalerType):
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			elemValue := reflect.New(t.Elem())
+			if err := elemValue.Interface().(ResultSetValueUnmarshaler).UnmarshalResultSetValue(cell); err != nil {
+				return ErrBadResponse(fmt.Sprintf("cannot unmarshal %s: %s", header, err))
+			}
+			fieldValue.Set(elemValue)
+			return nil
+		}, nil
+	}
+
+	assign := assignerFor(t, header)
+	if option == "" {
+		return assign, nil
+	}
+	converter, ok := valueConverters[option]
+	if !ok {
+		return nil, fmt.Errorf("unknown option `%s` for %s", option, header)
+	}
+	return func(fieldValue reflect.Value, cell interface{}) error {
+		converted, err := converter.decode(cell)
+		if err != nil {
+			return ErrBadResponse(fmt.Sprintf("cannot convert %s: %s", header, err))
+		}
+		if converted == nil {
+			return nil
+		}
+		return assign(fieldValue, converted)
+	}, nil
+}
+
+// assignerFor returns a setter that assigns cells to a field of type t,
+// with fast paths for the types JSON cells decode to.
+func assignerFor(t reflect.Type, header string) setter {
+	convert := func(fieldValue reflect.Value, cell interface{}) error {
+		return convertValue(fieldValue, cell, header)
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			if s, ok := cell.(string); ok {
+				fieldValue.SetString(s)
+				return nil
+			}
+			return convert(fieldValue, cell)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			if f, ok := cell.(float64); ok {
+				fieldValue.SetInt(int64(f))
+				return nil
+			}
+			return convert(fieldValue, cell)
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			if f, ok := cell.(float64); ok {
+				fieldValue.SetFloat(f)
+				return nil
+			}
+			return convert(fieldValue, cell)
+		}
+	case reflect.Bool:
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			if b, ok := cell.(bool); ok {
+				fieldValue.SetBool(b)
+				return nil
+			}
+			return convert(fieldValue, cell)
+		}
+	case reflect.Ptr:
+		elem := assignerFor(t.Elem(), header)
+		return func(fieldValue reflect.Value, cell interface{}) error {
+			elemValue := reflect.New(t.Elem())
+			if err := elem(elemValue.Elem(), cell); err != nil {
+				return err
+			}
+			fieldValue.Set(elemValue)
+			return nil
+		}
+	}
+	return convert
+}
+
+// convertValue assigns a cell to a field of any type it's convertible to,
+// or a pointer to one.
+func convertValue(fieldValue reflect.Value, cell interface{}, header string) error {
+	rowValue := reflect.ValueOf(cell)
+	rowValueType := rowValue.Type()
+	fieldValueType := fieldValue.Type()
+
+	if rowValueType.ConvertibleTo(fieldValueType) {
+		fieldValue.Set(rowValue.Convert(fieldValueType))
+		return nil
+	}
+
+	if fieldValueType.Kind() == reflect.Ptr && rowValueType.ConvertibleTo(fieldValueType.Elem()) {
+		// The field is a pointer to the right type.
+		elemValue := reflect.New(fieldValueType.Elem())
+		reflect.Indirect(elemValue).Set(rowValue.Convert(fieldValueType.Elem()))
+		fieldValue.Set(elemValue)
+		return nil
+	}
+
+	return ErrBadResponse(fmt.Sprintf("cannot convert `%v` to `%v` for %s", rowValueType, fieldValueType, header))
+}
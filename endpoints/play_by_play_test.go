@@ -0,0 +1,58 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestPlayByPlay(t *testing.T) {
+	var resp PlayByPlayResponse
+	if err := testRequester.Request(context.Background(), "playbyplayv2", &PlayByPlayParams{
+		GameID:      "0021401185",
+		Season:      "2014-15",
+		SeasonType:  "Regular Season",
+		StartPeriod: 1,
+		EndPeriod:   10,
+		StartRange:  0,
+		EndRange:    55800,
+		RangeType:   2,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	events := resp.ToData()
+	if len(events) != len(resp.PlayByPlay) {
+		t.Fatalf("expected %v events, got %v", len(resp.PlayByPlay), len(events))
+	}
+
+	var shots, made, points int
+	for i, event := range events {
+		if event.Number != i+1 || event.Period != 1 {
+			t.Errorf("unexpected event: %+v", event)
+		}
+		if event.Shot != nil {
+			shots++
+			if event.Shot.Made {
+				made++
+				points += event.Shot.PointsScored
+			}
+			if event.Player1 == nil {
+				t.Errorf("expected a shooter for event %v", event.Number)
+			}
+		}
+	}
+	if shots != 4 || made != 2 || points != 5 {
+		t.Errorf("expected 2 of 4 shots for 5 points, got %v of %v for %v", made, shots, points)
+	}
+
+	// Events involving a team rather than a player have no players.
+	timeout := events[11]
+	if timeout.Type != data.EventTypeTimeout || timeout.Player1 != nil {
+		t.Errorf("expected a team timeout, got %+v", timeout)
+	}
+	if events[2].Score == nil || events[2].PeriodTimeSeconds != 11*60+41 {
+		t.Errorf("expected a scoring play at 11:41, got %+v", events[2])
+	}
+}
@@ -0,0 +1,214 @@
+package endpoints
+
+import "github.com/jbowens/nbagame/data"
+
+// PlayerCareerStatsParams defines parameters for a PlayerCareerStats request.
+// PerMode is one of "Totals", "PerGame" or "Per36".
+// http://stats.nba.com/stats/playercareerstats?LeagueID=00&PerMode=PerGame&PlayerID=2594
+type PlayerCareerStatsParams struct {
+	LeagueID string `json:"LeagueID"`
+	PerMode  string `json:"PerMode"`
+	PlayerID int    `json:"PlayerID"`
+}
+
+// PlayerCareerStatsResponse is the type for all result sets returned by the
+// 'playercareerstats' resource.
+type PlayerCareerStatsResponse struct {
+	SeasonTotalsRegularSeason []*PlayerSeasonTotalsRow        `nbagame:"SeasonTotalsRegularSeason"`
+	CareerTotalsRegularSeason []*PlayerCareerTotalsRow        `nbagame:"CareerTotalsRegularSeason"`
+	SeasonTotalsPostSeason    []*PlayerSeasonTotalsRow        `nbagame:"SeasonTotalsPostSeason"`
+	CareerTotalsPostSeason    []*PlayerCareerTotalsRow        `nbagame:"CareerTotalsPostSeason"`
+	SeasonTotalsAllStarSeason []*PlayerSeasonTotalsRow        `nbagame:"SeasonTotalsAllStarSeason"`
+	CareerTotalsAllStarSeason []*PlayerCareerTotalsRow        `nbagame:"CareerTotalsAllStarSeason"`
+	SeasonTotalsCollegeSeason []*PlayerCollegeSeasonTotalsRow `nbagame:"SeasonTotalsCollegeSeason"`
+	CareerTotalsCollegeSeason []*PlayerCollegeCareerTotalsRow `nbagame:"CareerTotalsCollegeSeason"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (resp *PlayerCareerStatsResponse) ToData(playerID int, perMode data.PerMode) *data.PlayerCareer {
+	career := &data.PlayerCareer{
+		PlayerID:            playerID,
+		PerMode:             perMode,
+		RegularSeason:       playerSeasons(resp.SeasonTotalsRegularSeason),
+		PostSeason:          playerSeasons(resp.SeasonTotalsPostSeason),
+		AllStar:             playerSeasons(resp.SeasonTotalsAllStarSeason),
+		CareerRegularSeason: careerTotals(resp.CareerTotalsRegularSeason),
+		CareerPostSeason:    careerTotals(resp.CareerTotalsPostSeason),
+		CareerAllStar:       careerTotals(resp.CareerTotalsAllStarSeason),
+	}
+	for _, r := range resp.SeasonTotalsCollegeSeason {
+		career.College = append(career.College, r.ToPlayerCollegeSeason())
+	}
+	if len(resp.CareerTotalsCollegeSeason) > 0 {
+		stats := resp.CareerTotalsCollegeSeason[0].AggregateStatLine.ToAggregateStats()
+		career.CareerCollege = &stats
+	}
+	return career
+}
+
+// playerSeasons converts season totals rows into one PlayerSeason per
+// season. A player who played for more than one team during a season has a
+// row for each team, and a row with the totals across the teams.
+func playerSeasons(rows []*PlayerSeasonTotalsRow) []*data.PlayerSeason {
+	var order []string
+	seen := make(map[string]bool)
+	splits := make(map[string][]*data.PlayerSeason)
+	totals := make(map[string]*data.PlayerSeason)
+	for _, r := range rows {
+		if !seen[r.SeasonID] {
+			seen[r.SeasonID] = true
+			order = append(order, r.SeasonID)
+		}
+		if r.TeamID == 0 {
+			totals[r.SeasonID] = r.ToPlayerSeason()
+		} else {
+			splits[r.SeasonID] = append(splits[r.SeasonID], r.ToPlayerSeason())
+		}
+	}
+
+	seasons := make([]*data.PlayerSeason, 0, len(order))
+	for _, id := range order {
+		if total, ok := totals[id]; ok {
+			total.TeamSplits = splits[id]
+			seasons = append(seasons, total)
+			continue
+		}
+		seasons = append(seasons, splits[id]...)
+	}
+	return seasons
+}
+
+// careerTotals returns the stats from a career totals result set, or nil if
+// the player doesn't have any.
+func careerTotals(rows []*PlayerCareerTotalsRow) *data.AggregateStats {
+	if len(rows) == 0 {
+		return nil
+	}
+	stats := rows[0].AggregateStatLine.ToAggregateStats()
+	return &stats
+}
+
+// PlayerSeasonTotalsRow represents the schema returned for the
+// 'SeasonTotalsRegularSeason', 'SeasonTotalsPostSeason' and
+// 'SeasonTotalsAllStarSeason' result sets, returned from the
+// 'playercareerstats' resource.
+type PlayerSeasonTotalsRow struct {
+	PlayerID         int     `nbagame:"PLAYER_ID"`
+	SeasonID         string  `nbagame:"SEASON_ID"`
+	LeagueID         string  `nbagame:"LEAGUE_ID"`
+	TeamID           int     `nbagame:"TEAM_ID"`
+	TeamAbbreviation string  `nbagame:"TEAM_ABBREVIATION"`
+	PlayerAge        float64 `nbagame:"PLAYER_AGE"`
+	AggregateStatLine
+}
+
+// ToPlayerSeason converts this row into a PlayerSeason data struct.
+func (r *PlayerSeasonTotalsRow) ToPlayerSeason() *data.PlayerSeason {
+	return &data.PlayerSeason{
+		Season:           data.Season(r.SeasonID),
+		TeamID:           r.TeamID,
+		TeamAbbreviation: r.TeamAbbreviation,
+		PlayerAge:        r.PlayerAge,
+		AggregateStats:   r.AggregateStatLine.ToAggregateStats(),
+	}
+}
+
+// PlayerCareerTotalsRow represents the schema returned for the
+// 'CareerTotalsRegularSeason', 'CareerTotalsPostSeason' and
+// 'CareerTotalsAllStarSeason' result sets, returned from the
+// 'playercareerstats' resource.
+type PlayerCareerTotalsRow struct {
+	PlayerID int    `nbagame:"PLAYER_ID"`
+	LeagueID string `nbagame:"LEAGUE_ID"`
+	TeamID   int    `nbagame:"TEAM_ID"`
+	AggregateStatLine
+}
+
+// PlayerCollegeSeasonTotalsRow represents the schema returned for
+// 'SeasonTotalsCollegeSeason' result sets, returned from the
+// 'playercareerstats' resource.
+type PlayerCollegeSeasonTotalsRow struct {
+	PlayerID       int     `nbagame:"PLAYER_ID"`
+	SeasonID       string  `nbagame:"SEASON_ID"`
+	LeagueID       string  `nbagame:"LEAGUE_ID"`
+	OrganizationID int     `nbagame:"ORGANIZATION_ID"`
+	SchoolName     string  `nbagame:"SCHOOL_NAME"`
+	PlayerAge      float64 `nbagame:"PLAYER_AGE"`
+	AggregateStatLine
+}
+
+// ToPlayerCollegeSeason converts this row into a PlayerCollegeSeason data
+// struct.
+func (r *PlayerCollegeSeasonTotalsRow) ToPlayerCollegeSeason() *data.PlayerCollegeSeason {
+	return &data.PlayerCollegeSeason{
+		Season:         data.Season(r.SeasonID),
+		OrganizationID: r.OrganizationID,
+		SchoolName:     r.SchoolName,
+		PlayerAge:      r.PlayerAge,
+		AggregateStats: r.AggregateStatLine.ToAggregateStats(),
+	}
+}
+
+// PlayerCollegeCareerTotalsRow represents the schema returned for
+// 'CareerTotalsCollegeSeason' result sets, returned from the
+// 'playercareerstats' resource.
+type PlayerCollegeCareerTotalsRow struct {
+	PlayerID       int    `nbagame:"PLAYER_ID"`
+	LeagueID       string `nbagame:"LEAGUE_ID"`
+	OrganizationID int    `nbagame:"ORGANIZATION_ID"`
+	AggregateStatLine
+}
+
+// AggregateStatLine contains a summary of statistics over many games, as
+// totals or averages.
+type AggregateStatLine struct {
+	GamesPlayed            int     `nbagame:"GP"`
+	GamesStarted           int     `nbagame:"GS"`
+	Minutes                float64 `nbagame:"MIN"`
+	FieldGoalsMade         float64 `nbagame:"FGM"`
+	FieldGoalsAttempted    float64 `nbagame:"FGA"`
+	FieldGoalPercentage    float64 `nbagame:"FG_PCT"`
+	ThreePointersMade      float64 `nbagame:"FG3M"`
+	ThreePointersAttempted float64 `nbagame:"FG3A"`
+	ThreePointPercentage   float64 `nbagame:"FG3_PCT"`
+	FreeThrowsMade         float64 `nbagame:"FTM"`
+	FreeThrowsAttempted    float64 `nbagame:"FTA"`
+	FreeThrowPercentage    float64 `nbagame:"FT_PCT"`
+	OffensiveRebounds      float64 `nbagame:"OREB"`
+	DefensiveRebounds      float64 `nbagame:"DREB"`
+	Rebounds               float64 `nbagame:"REB"`
+	Assists                float64 `nbagame:"AST"`
+	Steals                 float64 `nbagame:"STL"`
+	Blocks                 float64 `nbagame:"BLK"`
+	Turnovers              float64 `nbagame:"TOV"`
+	PersonalFouls          float64 `nbagame:"PF"`
+	Points                 float64 `nbagame:"PTS"`
+}
+
+// ToAggregateStats converts an AggregateStatLine into a data AggregateStats
+// struct.
+func (sl *AggregateStatLine) ToAggregateStats() data.AggregateStats {
+	return data.AggregateStats{
+		GamesPlayed:            sl.GamesPlayed,
+		GamesStarted:           sl.GamesStarted,
+		Minutes:                sl.Minutes,
+		FieldGoalsMade:         sl.FieldGoalsMade,
+		FieldGoalsAttempted:    sl.FieldGoalsAttempted,
+		FieldGoalPercentage:    sl.FieldGoalPercentage,
+		ThreePointersMade:      sl.ThreePointersMade,
+		ThreePointersAttempted: sl.ThreePointersAttempted,
+		ThreePointPercentage:   sl.ThreePointPercentage,
+		FreeThrowsMade:         sl.FreeThrowsMade,
+		FreeThrowsAttempted:    sl.FreeThrowsAttempted,
+		FreeThrowPercentage:    sl.FreeThrowPercentage,
+		OffensiveRebounds:      sl.OffensiveRebounds,
+		DefensiveRebounds:      sl.DefensiveRebounds,
+		Rebounds:               sl.Rebounds,
+		Assists:                sl.Assists,
+		Steals:                 sl.Steals,
+		Blocks:                 sl.Blocks,
+		Turnovers:              sl.Turnovers,
+		PersonalFouls:          sl.PersonalFouls,
+		Points:                 sl.Points,
+	}
+}
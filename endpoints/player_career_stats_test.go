@@ -0,0 +1,57 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestPlayerCareerStats(t *testing.T) {
+	var resp PlayerCareerStatsResponse
+	if err := testRequester.Request(context.Background(), "playercareerstats", &PlayerCareerStatsParams{
+		LeagueID: "00",
+		PerMode:  "PerGame",
+		PlayerID: 2594,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	career := resp.ToData(2594, data.PerGame)
+	if len(career.RegularSeason) != 12 {
+		t.Fatalf("expected 12 regular seasons, got %v", len(career.RegularSeason))
+	}
+	if len(career.PostSeason) == 0 || len(career.AllStar) != 1 || len(career.College) != 4 {
+		t.Errorf("expected post season, all-star and college seasons, got %v, %v and %v",
+			len(career.PostSeason), len(career.AllStar), len(career.College))
+	}
+	if career.CareerRegularSeason == nil || career.CareerPostSeason == nil || career.CareerAllStar == nil || career.CareerCollege == nil {
+		t.Fatalf("expected career totals, got %+v", career)
+	}
+
+	var gamesPlayed int
+	for _, season := range career.RegularSeason {
+		gamesPlayed += season.GamesPlayed
+	}
+	if gamesPlayed != career.CareerRegularSeason.GamesPlayed {
+		t.Errorf("expected %v career games, got %v", career.CareerRegularSeason.GamesPlayed, gamesPlayed)
+	}
+
+	// Korver was traded from Philadelphia to Utah during the 2007-08 season.
+	traded := career.Season("2007-08")
+	if traded == nil {
+		t.Fatal("expected the 2007-08 season")
+	}
+	if traded.TeamID != 0 || traded.TeamAbbreviation != "TOT" || len(traded.TeamSplits) != 2 {
+		t.Fatalf("expected totals split between two teams, got %+v", traded)
+	}
+	if traded.TeamSplits[0].TeamAbbreviation != "PHI" || traded.TeamSplits[1].TeamAbbreviation != "UTA" {
+		t.Errorf("expected splits for PHI and UTA, got %+v and %+v", traded.TeamSplits[0], traded.TeamSplits[1])
+	}
+	if traded.TeamSplits[0].GamesPlayed+traded.TeamSplits[1].GamesPlayed != traded.GamesPlayed {
+		t.Errorf("expected the splits' games to add up to %v", traded.GamesPlayed)
+	}
+	if atlanta := career.Season("2014-15"); atlanta == nil || atlanta.TeamAbbreviation != "ATL" || atlanta.TeamSplits != nil {
+		t.Errorf("expected one team in 2014-15, got %+v", atlanta)
+	}
+}
@@ -0,0 +1,110 @@
+package endpoints
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// PlayerGameLogParams defines parameters for a PlayerGameLog request.
+// DateFrom and DateTo are optional.
+type PlayerGameLogParams struct {
+	LeagueID   string    `json:"LeagueID"`
+	PlayerID   int       `json:"PlayerID"`
+	Season     string    `json:"Season"`
+	SeasonType string    `json:"SeasonType"`
+	DateFrom   time.Time `json:"DateFrom"`
+	DateTo     time.Time `json:"DateTo"`
+}
+
+// PlayerGameLogResponse is the type for all result sets returned by the
+// 'playergamelog' resource.
+type PlayerGameLogResponse struct {
+	PlayerGameLog []*PlayerGameLogRow `nbagame:"PlayerGameLog"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (resp *PlayerGameLogResponse) ToData() []*data.PlayerGameLogEntry {
+	entries := make([]*data.PlayerGameLogEntry, 0, len(resp.PlayerGameLog))
+	for _, r := range resp.PlayerGameLog {
+		entries = append(entries, r.ToPlayerGameLogEntry())
+	}
+	return entries
+}
+
+// PlayerGameLogRow represents the schema returned for 'PlayerGameLog' result
+// sets, returned from the 'playergamelog' resource.
+//
+// Example URL:
+// http://stats.nba.com/stats/playergamelog?LeagueID=00&PlayerID=201952&Season=2014-15&SeasonType=Regular+Season
+type PlayerGameLogRow struct {
+	SeasonID               string    `nbagame:"SEASON_ID"`
+	PlayerID               int       `nbagame:"Player_ID"`
+	GameID                 string    `nbagame:"Game_ID"`
+	Date                   time.Time `nbagame:"GAME_DATE,date"`
+	Matchup                string    `nbagame:"MATCHUP"`
+	WinOrLoss              string    `nbagame:"WL"`
+	SecondsPlayed          int       `nbagame:"MIN,seconds"`
+	FieldGoalsMade         int       `nbagame:"FGM"`
+	FieldGoalsAttempted    int       `nbagame:"FGA"`
+	FieldGoalPercentage    float64   `nbagame:"FG_PCT"`
+	ThreePointersMade      int       `nbagame:"FG3M"`
+	ThreePointersAttempted int       `nbagame:"FG3A"`
+	ThreePointPercentage   float64   `nbagame:"FG3_PCT"`
+	FreeThrowsMade         int       `nbagame:"FTM"`
+	FreeThrowsAttempted    int       `nbagame:"FTA"`
+	FreeThrowPercentage    float64   `nbagame:"FT_PCT"`
+	OffensiveRebounds      int       `nbagame:"OREB"`
+	DefensiveRebounds      int       `nbagame:"DREB"`
+	Rebounds               int       `nbagame:"REB"`
+	Assists                int       `nbagame:"AST"`
+	Steals                 int       `nbagame:"STL"`
+	Blocks                 int       `nbagame:"BLK"`
+	Turnovers              int       `nbagame:"TOV"`
+	PersonalFouls          int       `nbagame:"PF"`
+	Points                 int       `nbagame:"PTS"`
+	PlusMinus              int       `nbagame:"PLUS_MINUS"`
+}
+
+// ToStatLine returns the row's stats as a StatLine.
+func (r *PlayerGameLogRow) ToStatLine() *StatLine {
+	return &StatLine{
+		MinutesPlayed:          fmt.Sprintf("%d:%02d", r.SecondsPlayed/60, r.SecondsPlayed%60),
+		FieldGoalsMade:         r.FieldGoalsMade,
+		FieldGoalsAttempted:    r.FieldGoalsAttempted,
+		FieldGoalPercentage:    r.FieldGoalPercentage,
+		ThreePointersMade:      r.ThreePointersMade,
+		ThreePointersAttempted: r.ThreePointersAttempted,
+		ThreePointPercentage:   r.ThreePointPercentage,
+		FreeThrowsMade:         r.FreeThrowsMade,
+		FreeThrowsAttempted:    r.FreeThrowsAttempted,
+		FreeThrowPercentage:    r.FreeThrowPercentage,
+		OffensiveRebounds:      r.OffensiveRebounds,
+		DefensiveRebounds:      r.DefensiveRebounds,
+		Rebounds:               r.Rebounds,
+		Assists:                r.Assists,
+		Steals:                 r.Steals,
+		Blocks:                 r.Blocks,
+		Turnovers:              r.Turnovers,
+		PersonalFouls:          r.PersonalFouls,
+		Points:                 r.Points,
+		PlusMinus:              r.PlusMinus,
+	}
+}
+
+// ToPlayerGameLogEntry converts this row into a PlayerGameLogEntry data
+// struct.
+func (r *PlayerGameLogRow) ToPlayerGameLogEntry() *data.PlayerGameLogEntry {
+	return &data.PlayerGameLogEntry{
+		PlayerID: r.PlayerID,
+		GameID:   data.GameID(r.GameID),
+		Date:     data.Date(r.Date),
+		Matchup:  r.Matchup,
+		// Matchups look like "ATL vs. NOP" at home and "ATL @ LAL" away.
+		Location: data.HomeOrAway(!strings.Contains(r.Matchup, "@")),
+		Won:      r.WinOrLoss == "W",
+		Stats:    *r.ToStatLine().ToStats(),
+	}
+}
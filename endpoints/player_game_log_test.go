@@ -0,0 +1,66 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestPlayerGameLog(t *testing.T) {
+	var resp PlayerGameLogResponse
+	if err := testRequester.Request(context.Background(), "playergamelog", &PlayerGameLogParams{
+		LeagueID:   "00",
+		PlayerID:   201952,
+		Season:     "2014-15",
+		SeasonType: "Regular Season",
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	games := resp.ToData()
+	if len(games) < 50 {
+		t.Fatalf("expected 50 or more games, got %v", len(games))
+	}
+	for _, game := range games {
+		if game.GameID.IsPlayoff() || game.GameID.Season() != "2014-15" {
+			t.Errorf("expected a 2014-15 regular season game, got %v", game.GameID)
+		}
+		if game.Rebounds != game.OffensiveRebounds+game.DefensiveRebounds {
+			t.Errorf("expected rebounds to add up, got %+v", game.Stats)
+		}
+	}
+
+	var home, wins int
+	for _, game := range games {
+		if game.Location == data.Home {
+			home++
+		}
+		if game.Won {
+			wins++
+		}
+		if game.SecondsPlayed == 0 || game.SecondsPlayed%60 != 0 {
+			t.Errorf("expected whole minutes played, got %v seconds", game.SecondsPlayed)
+		}
+	}
+	if home == 0 || home == len(games) {
+		t.Errorf("expected home and away games, got %v home games of %v", home, len(games))
+	}
+	if wins == 0 {
+		t.Error("expected some wins")
+	}
+}
+
+func TestPlayerGameLogFractionalMinutes(t *testing.T) {
+	rs := &ResultSet{
+		Headers: []string{"Game_ID", "MIN", "PTS"},
+		RowSet:  [][]interface{}{{"0021400001", 34.5, 21.0}},
+	}
+	var rows []*PlayerGameLogRow
+	if _, err := rs.DecodeWith(&rows, DecodeLenient); err != nil {
+		t.Fatal(err)
+	}
+	if stats := rows[0].ToStatLine().ToStats(); stats.SecondsPlayed != 34*60+30 || stats.Points != 21 {
+		t.Errorf("expected 34:30 played, got %+v", stats)
+	}
+}
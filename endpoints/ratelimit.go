@@ -0,0 +1,145 @@
+package endpoints
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRequestsPerSecond is the request rate allowed by the
+	// DefaultRateLimiter.
+	DefaultRequestsPerSecond = 5
+	// DefaultBurst is the burst size allowed by the DefaultRateLimiter.
+	DefaultBurst = 10
+
+	// rateLimitFloorDivisor bounds how far a RateLimiter slows down after
+	// repeated rate-limit responses, as a fraction of its configured rate.
+	rateLimitFloorDivisor = 20
+	// rateLimitRecoverySteps is the number of successful requests it takes
+	// to recover from the slowest rate to the configured rate.
+	rateLimitRecoverySteps = 50
+)
+
+// DefaultRateLimiter is the RateLimiter used by the DefaultRequester, and
+// shared by default by every Client constructed with NewClient.
+var DefaultRateLimiter = NewRateLimiter(DefaultRequestsPerSecond, DefaultBurst)
+
+// RateLimiter is a token bucket limiting the rate of requests made through
+// every Requester that shares it. It adapts to the server: each rate-limit
+// response halves the rate, and the rate gradually recovers to its configured
+// value as requests succeed.
+type RateLimiter struct {
+	mu         sync.Mutex
+	configured float64 // requests per second, as configured
+	rate       float64 // current requests per second
+	burst      float64
+	tokens     float64
+	last       time.Time
+}
+
+// NewRateLimiter constructs a RateLimiter allowing requestsPerSecond
+// requests per second on average, and bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	l := &RateLimiter{}
+	l.SetLimit(requestsPerSecond, burst)
+	return l
+}
+
+// SetLimit reconfigures the rate and burst size of the limiter.
+func (l *RateLimiter) SetLimit(requestsPerSecond float64, burst int) {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.configured = requestsPerSecond
+	l.rate = requestsPerSecond
+	l.burst = float64(burst)
+	l.tokens = l.burst
+	l.last = time.Time{}
+}
+
+// Rate returns the number of requests per second currently allowed, which
+// may be lower than the configured rate after rate-limit responses.
+func (l *RateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+// Wait blocks until a request may be made, or until the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if err := sleep(ctx, delay); err != nil {
+		// Give back the token we never used.
+		l.mu.Lock()
+		l.tokens = minFloat(l.tokens+1, l.burst)
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// must wait before using it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+	if !l.last.IsZero() {
+		elapsed := now.Sub(l.last).Seconds()
+		l.tokens = minFloat(l.tokens+elapsed*l.rate, l.burst)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Backoff halves the limiter's rate in response to the server rate limiting
+// a request, and empties the bucket so that no burst follows.
+func (l *RateLimiter) Backoff() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = maxFloat(l.rate/2, l.configured/rateLimitFloorDivisor)
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+}
+
+// Success records a successful request, moving the limiter's rate back
+// towards its configured rate.
+func (l *RateLimiter) Success() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = minFloat(l.rate+l.configured/rateLimitRecoverySteps, l.configured)
+}
+
+// isRateLimitStatus reports whether the status code indicates the server is
+// throttling us.
+func isRateLimitStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
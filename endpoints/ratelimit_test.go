@@ -0,0 +1,47 @@
+package endpoints
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowsBursts(t *testing.T) {
+	limiter := NewRateLimiter(10, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if delay := limiter.reserve(now); delay != 0 {
+			t.Fatalf("request %v within the burst was delayed %v", i, delay)
+		}
+	}
+	if delay := limiter.reserve(now); delay != 100*time.Millisecond {
+		t.Errorf("expected the request after the burst to wait 100ms, got %v", delay)
+	}
+
+	// After a second, the bucket should have refilled.
+	if delay := limiter.reserve(now.Add(time.Second)); delay != 0 {
+		t.Errorf("expected the bucket to have refilled, but waited %v", delay)
+	}
+}
+
+func TestRateLimiterAdapts(t *testing.T) {
+	limiter := NewRateLimiter(10, 1)
+
+	limiter.Backoff()
+	if rate := limiter.Rate(); rate != 5 {
+		t.Errorf("expected the rate to halve to 5, got %v", rate)
+	}
+	for i := 0; i < 10; i++ {
+		limiter.Backoff()
+	}
+	if rate := limiter.Rate(); rate != 10.0/rateLimitFloorDivisor {
+		t.Errorf("expected the rate to bottom out at %v, got %v", 10.0/rateLimitFloorDivisor, rate)
+	}
+
+	for i := 0; i < rateLimitRecoverySteps; i++ {
+		limiter.Success()
+	}
+	if rate := limiter.Rate(); rate != 10 {
+		t.Errorf("expected the rate to recover to 10, got %v", rate)
+	}
+}
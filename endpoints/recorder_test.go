@@ -0,0 +1,57 @@
+package endpoints
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRecordsAndReplays(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nbagame-recorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"resultSets": [{"name": "GameSummary",
+			"headers": ["GAME_ID", "GAME_STATUS_ID"], "rowSet": [["0021401185", 3]]}]}`))
+	}))
+	defer server.Close()
+
+	requester := Requester{
+		Domain:   strings.TrimPrefix(server.URL, "http://"),
+		Recorder: NewRecorder(dir, Record),
+	}
+	params := BoxScoreSummaryParams{GameID: "0021401185"}
+	var recorded testGameStatusResponse
+	if err := requester.Request(context.Background(), "boxscoresummaryv2", params, &recorded); err != nil {
+		t.Fatal(err)
+	}
+
+	requester.Recorder.Mode = Replay
+	var replayed testGameStatusResponse
+	if err := requester.Request(context.Background(), "boxscoresummaryv2", params, &replayed); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request to the server, got %v", requests)
+	}
+	if len(replayed.GameSummary) != 1 || replayed.GameSummary[0].GameStatusID != 3 {
+		t.Errorf("expected the recorded game summary, got %+v", replayed.GameSummary)
+	}
+
+	err = requester.Request(context.Background(), "boxscoresummaryv2", BoxScoreSummaryParams{GameID: "0021401186"}, &replayed)
+	if err == nil {
+		t.Error("expected an error replaying a request that wasn't recorded")
+	}
+	if requests != 1 {
+		t.Errorf("expected replay to never reach the server, got %v requests", requests)
+	}
+}
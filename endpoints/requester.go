@@ -2,78 +2,296 @@ package endpoints
 
 import (
 	"bytes"
+	"context"
+	"encoding"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
-	NBAStatsDomain = "stats.nba.com"
+	NBAStatsDomain     = "stats.nba.com"
+	NBAStatsPathPrefix = "stats"
+	NBAStatsReferer    = "http://stats.nba.com"
 )
 
 // DefaultRequester is the default Requester using default values for the
 // endpoints.
 var DefaultRequester = Requester{
 	Domain:     NBAStatsDomain,
-	PathPrefix: "stats",
+	PathPrefix: NBAStatsPathPrefix,
+	Retry:      &DefaultRetryPolicy,
+	Limiter:    DefaultRateLimiter,
 }
 
 // Requester performs requests to the stats.nba.com server's endpoints.
 type Requester struct {
 	Domain     string
 	PathPrefix string
-	client     http.Client
+
+	// Scheme is the URL scheme used for requests. It defaults to "http".
+	Scheme string
+	// Header holds headers sent with every request. They're applied after
+	// the default Referer header, so they may override it.
+	Header http.Header
+	// Timeout bounds the duration of each attempt at a request, including
+	// reading the response body. Zero means no timeout beyond the request's
+	// context.
+	Timeout time.Duration
+	// HTTPClient is the client used to perform requests. If nil,
+	// http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Retry determines how failed requests are retried. If nil, requests
+	// are attempted only once.
+	Retry *RetryPolicy
+	// Limiter limits the rate of requests. Requesters sharing a Limiter
+	// share its budget. If nil, requests are not rate limited.
+	Limiter *RateLimiter
+	// Cache stores responses so that repeated requests for the same
+	// endpoint and parameters aren't sent to the server. If nil, responses
+	// are not cached.
+	Cache Cache
+	// CachePolicy decides how long responses are cached. If nil,
+	// DefaultCachePolicy is used.
+	CachePolicy *CachePolicy
+	// Recorder records responses to, or replays them from, a fixtures
+	// directory. If nil, requests are always sent to the server.
+	Recorder *Recorder
+	// Middleware wraps every request, in order, so that the first
+	// Middleware sees the request first and the response last.
+	Middleware []Middleware
+	// DecodeMode determines how responses that don't match the expected
+	// schema are decoded. Any SchemaDrift found is recorded on the Call.
+	DecodeMode DecodeMode
 }
 
 // EndpointURL returns the absolute URL for an endpoint.
 func (r *Requester) EndpointURL(endpoint string) string {
-	return fmt.Sprintf("http://%s/%s/%s", r.Domain, r.PathPrefix, endpoint)
+	scheme := r.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	if r.PathPrefix == "" {
+		return fmt.Sprintf("%s://%s/%s", scheme, r.Domain, endpoint)
+	}
+	return fmt.Sprintf("%s://%s/%s/%s", scheme, r.Domain, r.PathPrefix, endpoint)
+}
+
+func (r *Requester) httpClient() *http.Client {
+	if r.HTTPClient != nil {
+		return r.HTTPClient
+	}
+	return http.DefaultClient
 }
 
 // Request performs a request against the given endpoint with the provided
-// parameters.
-func (r *Requester) Request(endpoint string, params interface{}, resp interface{}) error {
-	endpointURL, err := url.Parse(r.EndpointURL(endpoint))
+// parameters. The request is abandoned if ctx is cancelled or its deadline
+// passes before the response has been read.
+func (r *Requester) Request(ctx context.Context, endpoint string, params interface{}, resp interface{}) error {
+	urlParams, err := r.makeParams(params)
 	if err != nil {
 		return err
 	}
 
-	urlParams, err := r.makeParams(params)
+	header := http.Header{}
+	header.Set("Referer", NBAStatsReferer)
+	for key, values := range r.Header {
+		header[key] = append([]string(nil), values...)
+	}
+	call := &Call{
+		Endpoint: endpoint,
+		Params:   urlParams,
+		Header:   header,
+		dest:     resp,
+	}
+	return r.chain(r.do)(ctx, call)
+}
+
+// do performs a Call. It's the innermost Handler, wrapped by the
+// Requester's Middleware.
+func (r *Requester) do(ctx context.Context, call *Call) error {
+	endpointURL, err := url.Parse(r.EndpointURL(call.Endpoint))
 	if err != nil {
 		return err
 	}
-	endpointURL.RawQuery = urlParams.Encode()
+	endpointURL.RawQuery = call.Params.Encode()
+
+	key := cacheKey(call.Endpoint, call.Params)
+	body, cached := r.cacheGet(key)
+	call.Cached = cached
+	if !cached {
+		body, err = r.retrieve(ctx, call, endpointURL.String())
+		if err != nil {
+			return requestError(call.Endpoint, call.Params, err)
+		}
+	}
+	call.Bytes = len(body)
 
-	req, err := http.NewRequest("GET", endpointURL.String(), nil)
+	response, drift, err := streamResponse(body, &call.dest, r.DecodeMode)
+	call.Drift = drift
+	if response != nil {
+		call.Response = &ResponseView{response: response}
+	}
 	if err != nil {
-		return err
+		return &RequestError{
+			Endpoint:   call.Endpoint,
+			Params:     call.Params,
+			StatusCode: http.StatusOK,
+			Status:     http.StatusText(http.StatusOK),
+			Body:       snippet(body),
+			Err:        err,
+		}
 	}
-	req.Header.Set("Referer", "http://stats.nba.com")
 
-	httpResponse, err := r.client.Do(req)
+	if r.Cache != nil {
+		policy := r.cachePolicy()
+		policy.Observe(response)
+		if !cached {
+			if ttl := policy.TTL(call.Endpoint, call.Params, response); ttl > 0 {
+				r.Cache.Set(key, body, ttl)
+			}
+		}
+	}
+	return nil
+}
+
+// retrieve returns the response body for a request that isn't cached,
+// either from the server or from the Recorder.
+func (r *Requester) retrieve(ctx context.Context, call *Call, endpointURL string) ([]byte, error) {
+	if r.Recorder != nil && r.Recorder.Mode == Replay {
+		call.Cached = true
+		return r.Recorder.load(call.Endpoint, call.Params)
+	}
+
+	body, err := r.fetch(ctx, call, endpointURL)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if r.Recorder != nil && r.Recorder.Mode == Record {
+		if err := r.Recorder.save(call.Endpoint, call.Params, body); err != nil {
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+func (r *Requester) cacheGet(key string) ([]byte, bool) {
+	if r.Cache == nil {
+		return nil, false
+	}
+	return r.Cache.Get(key)
+}
+
+func (r *Requester) cachePolicy() *CachePolicy {
+	if r.CachePolicy != nil {
+		return r.CachePolicy
+	}
+	return DefaultCachePolicy
+}
+
+// fetch retrieves the body of the given URL, retrying transient failures
+// according to the Requester's RetryPolicy.
+func (r *Requester) fetch(ctx context.Context, call *Call, endpointURL string) ([]byte, error) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		if r.Limiter != nil {
+			if waitErr := r.Limiter.Wait(ctx); waitErr != nil {
+				if err == nil {
+					err = waitErr
+				}
+				return nil, err
+			}
+		}
+
+		var body []byte
+		call.Attempts++
+		body, err = r.fetchOnce(ctx, endpointURL, call.Header)
+		if err == nil {
+			call.StatusCode = http.StatusOK
+			if r.Limiter != nil {
+				r.Limiter.Success()
+			}
+			return body, nil
+		}
+
+		reqErr := err.(*RequestError)
+		call.StatusCode = reqErr.StatusCode
+		if r.Limiter != nil && isRateLimitStatus(reqErr.StatusCode) {
+			r.Limiter.Backoff()
+		}
+		if !reqErr.Retryable || attempt >= r.Retry.attempts() || ctx.Err() != nil {
+			return nil, err
+		}
+		if sleepErr := sleep(ctx, r.Retry.delay(attempt, reqErr.RetryAfter)); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// fetchOnce retrieves the body of the given URL. Any error is a
+// RequestError.
+func (r *Requester) fetchOnce(ctx context.Context, endpointURL string, header http.Header) ([]byte, error) {
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpointURL, nil)
+	if err != nil {
+		return nil, &RequestError{Err: err}
+	}
+	req.Header = header
+
+	httpResponse, err := r.httpClient().Do(req)
+	if err != nil {
+		// Network failures are transient, unless we gave up on the request.
+		return nil, &RequestError{Err: err, Retryable: ctx.Err() == nil}
 	}
 	defer httpResponse.Body.Close()
 
 	if httpResponse.StatusCode != http.StatusOK {
-		return fmt.Errorf("endpoint `%s` returned status `%s`", endpointURL, httpResponse.Status)
+		body, _ := ioutil.ReadAll(io.LimitReader(httpResponse.Body, bodySnippetLength))
+		return nil, &RequestError{
+			StatusCode: httpResponse.StatusCode,
+			Status:     httpResponse.Status,
+			Body:       snippet(body),
+			Retryable:  r.Retry.retryableStatus(httpResponse.StatusCode),
+			RetryAfter: parseRetryAfter(httpResponse.Header.Get("Retry-After"), time.Now()),
+		}
 	}
 
 	buf := new(bytes.Buffer)
 	if _, err = buf.ReadFrom(httpResponse.Body); err != nil {
-		return err
-	}
-	response, err := NewResponse(buf.Bytes())
-	if err != nil {
-		return err
+		return nil, &RequestError{
+			StatusCode: httpResponse.StatusCode,
+			Status:     httpResponse.Status,
+			Retryable:  ctx.Err() == nil,
+			Err:        err,
+		}
 	}
-
-	return response.Decode(&resp)
+	return buf.Bytes(), nil
 }
 
+// paramDateFormat is the format of dates in request parameters.
+const paramDateFormat = "01/02/2006"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// makeParams encodes a parameters struct as URL parameters. Each field is
+// named by its `json` tag, and may be a string, bool (sent as Y or N), int,
+// float, time.Time (sent as MM/DD/YYYY) or encoding.TextMarshaler. Nil
+// pointer fields are omitted, as are zero values of fields tagged with
+// omitempty. Untagged embedded structs contribute their own fields.
 func (r *Requester) makeParams(paramStruct interface{}) (url.Values, error) {
 	params := url.Values{}
 	rv := reflect.ValueOf(paramStruct)
@@ -81,28 +299,78 @@ func (r *Requester) makeParams(paramStruct interface{}) (url.Values, error) {
 	for rv.Kind() == reflect.Ptr {
 		rv = rv.Elem()
 	}
+	if err := addParams(params, rv); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
 
+func addParams(params url.Values, rv reflect.Value) error {
 	for i := 0; i < rv.NumField(); i++ {
 		fieldValue := rv.Field(i)
 		fieldType := rv.Type().Field(i)
 
-		fieldHeader := fieldType.Tag.Get("json")
-		if fieldHeader == "" {
-			// Skip any fields that don't have a tag.
+		tag := fieldType.Tag.Get("json")
+		if tag == "" {
+			if fieldType.Anonymous && fieldValue.Kind() == reflect.Struct {
+				if err := addParams(params, fieldValue); err != nil {
+					return err
+				}
+			}
+			// Skip any other fields that don't have a tag.
 			continue
 		}
-
-		switch fieldValue.Kind() {
-		case reflect.String:
-			params.Set(fieldHeader, fieldValue.String())
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "-" {
 			continue
-		case reflect.Int:
-			params.Set(fieldHeader, strconv.FormatInt(fieldValue.Int(), 10))
+		}
+
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		} else if options == "omitempty" && fieldValue.IsZero() {
 			continue
 		}
 
-		return nil, fmt.Errorf("unsupported request parameter type: %s for tag `%s`", fieldValue.Kind(), fieldHeader)
+		value, err := encodeParam(fieldValue)
+		if err != nil {
+			return fmt.Errorf("%s for tag `%s`", err, name)
+		}
+		params.Set(name, value)
 	}
+	return nil
+}
 
-	return params, nil
+func encodeParam(v reflect.Value) (string, error) {
+	if v.Type() == timeType {
+		// An unset date is sent as an empty parameter.
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(paramDateFormat), nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return "Y", nil
+		}
+		return "N", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("unsupported request parameter type: %s", v.Type())
 }
@@ -1,9 +1,30 @@
 package endpoints
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
 )
 
+// testRequester replays the synthetic responses in testdata, so that tests
+// don't depend on stats.nba.com. Run the tests with NBAGAME_RECORD=1 to
+// record them from stats.nba.com instead.
+var testRequester = &Requester{
+	Domain:     NBAStatsDomain,
+	PathPrefix: NBAStatsPathPrefix,
+	Retry:      &DefaultRetryPolicy,
+	Limiter:    DefaultRateLimiter,
+	Recorder:   NewRecorder("testdata", RecorderModeFromEnv()),
+}
+
 type testRequestParams struct {
 	Season      string `json:"Season"`
 	PlayerCount int    `json:"PlayerCount"`
@@ -28,6 +49,90 @@ func TestMakeParams(t *testing.T) {
 	}
 }
 
+type testPerMode int
+
+func (m testPerMode) MarshalText() ([]byte, error) {
+	return []byte([]string{"Totals", "PerGame"}[m]), nil
+}
+
+type testFilterParams struct {
+	LeagueID string `json:"LeagueID"`
+}
+
+type testOptionalParams struct {
+	testFilterParams
+	Season     data.Season `json:"Season"`
+	GameID     data.GameID `json:"GameID"`
+	PlayerOnly bool        `json:"PlayerOrTeam"`
+	Rookies    bool        `json:"Rookies,omitempty"`
+	MinHeight  float64     `json:"MinHeight"`
+	PerMode    testPerMode `json:"PerMode"`
+	DateFrom   time.Time   `json:"DateFrom"`
+	DateTo     time.Time   `json:"DateTo,omitempty"`
+	LastNGames int         `json:"LastNGames,omitempty"`
+	Period     *int        `json:"Period"`
+	TeamID     *int        `json:"TeamID"`
+	Ignored    string      `json:"-"`
+}
+
+func TestMakeParamsTypes(t *testing.T) {
+	period := 0
+	testParams := &testOptionalParams{
+		testFilterParams: testFilterParams{LeagueID: "00"},
+		Season:           "2014-15",
+		GameID:           "0021401185",
+		PlayerOnly:       true,
+		MinHeight:        6.5,
+		PerMode:          1,
+		DateFrom:         time.Date(2015, time.April, 3, 0, 0, 0, 0, time.UTC),
+		Period:           &period,
+		Ignored:          "ignored",
+	}
+
+	params, err := DefaultRequester.makeParams(testParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := url.Values{
+		"LeagueID":     {"00"},
+		"Season":       {"2014-15"},
+		"GameID":       {"0021401185"},
+		"PlayerOrTeam": {"Y"},
+		"MinHeight":    {"6.5"},
+		"PerMode":      {"PerGame"},
+		"DateFrom":     {"04/03/2015"},
+		"Period":       {"0"},
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("expected %v, got %v", expected, params)
+	}
+}
+
+func TestMakeParamsZeroDate(t *testing.T) {
+	params, err := DefaultRequester.makeParams(&PlayerGameLogParams{
+		LeagueID: "00",
+		DateTo:   time.Date(2015, time.January, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from, ok := params["DateFrom"]; !ok || from[0] != "" {
+		t.Errorf("expected an empty DateFrom, got %v", params)
+	}
+	if to := params.Get("DateTo"); to != "01/31/2015" {
+		t.Errorf("expected DateTo 01/31/2015, got %q", to)
+	}
+}
+
+func TestMakeParamsUnsupportedType(t *testing.T) {
+	testParams := struct {
+		PlayerIDs []int `json:"PlayerIDs"`
+	}{}
+	if _, err := DefaultRequester.makeParams(testParams); err == nil {
+		t.Error("expected an error for a slice parameter")
+	}
+}
+
 func TestRequest(t *testing.T) {
 	params := CommonAllPlayersParams{
 		Season:   "2014-15",
@@ -35,10 +140,33 @@ func TestRequest(t *testing.T) {
 	}
 
 	var resp CommonAllPlayersResponse
-	if err := DefaultRequester.Request("commonallplayers", params, &resp); err != nil {
+	if err := testRequester.Request(context.Background(), "commonallplayers", params, &resp); err != nil {
 		t.Fatal(err)
 	}
 	if len(resp.CommonAllPlayers) == 0 {
 		t.Error("Empty response for commonallplayers request.")
 	}
 }
+
+func TestRequestHonorsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	requester := Requester{
+		Domain:     strings.TrimPrefix(server.URL, "http://"),
+		PathPrefix: "stats",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var resp CommonAllPlayersResponse
+	err := requester.Request(ctx, "commonallplayers", CommonAllPlayersParams{}, &resp)
+	if err == nil {
+		t.Fatal("expected an error from a request that outlived its context")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to fail with the context's deadline, got %v", err)
+	}
+}
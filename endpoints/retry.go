@@ -0,0 +1,129 @@
+package endpoints
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryPolicy is the RetryPolicy used by the DefaultRequester. It
+// makes up to four attempts, backing off exponentially from half a second.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:     4,
+	InitialBackoff:  500 * time.Millisecond,
+	MaxBackoff:      15 * time.Second,
+	Multiplier:      2,
+	Jitter:          0.2,
+	RetryableStatus: IsRetryableStatus,
+}
+
+// RetryPolicy determines how a Requester retries requests that fail because
+// of a transport error or a retryable response status.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request,
+	// including the first. Values less than 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including delays
+	// requested by a Retry-After header.
+	MaxBackoff time.Duration
+	// Multiplier is the factor the delay grows by after every attempt.
+	Multiplier float64
+	// Jitter is the fraction, between 0 and 1, by which each delay is
+	// randomly varied so that concurrent requests don't retry in lockstep.
+	Jitter float64
+	// RetryableStatus reports whether a response with the given HTTP status
+	// code should be retried. If nil, IsRetryableStatus is used.
+	RetryableStatus func(statusCode int) bool
+}
+
+// IsRetryableStatus reports whether the HTTP status code indicates a
+// transient failure: 429 Too Many Requests or a 5xx gateway or availability
+// error.
+func IsRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) retryableStatus(statusCode int) bool {
+	if p == nil || p.RetryableStatus == nil {
+		return IsRetryableStatus(statusCode)
+	}
+	return p.RetryableStatus(statusCode)
+}
+
+// backoff returns the delay before the given retry, where the first retry
+// is retry 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		delay *= p.Multiplier
+	}
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+// delay returns how long to wait before the given retry, honoring the
+// server's Retry-After header if it provided one.
+func (p *RetryPolicy) delay(retry int, retryAfter time.Duration) time.Duration {
+	if retryAfter <= 0 {
+		return p.backoff(retry)
+	}
+	if p.MaxBackoff > 0 && retryAfter > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return retryAfter
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which may hold
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// sleep waits for the duration to elapse or the context to be done,
+// whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
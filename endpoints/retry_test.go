@@ -0,0 +1,86 @@
+package endpoints
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestRetriesTransientFailures(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"resultSets": [{"name": "CommonAllPlayers",
+				"headers": ["PERSON_ID", "DISPLAY_LAST_COMMA_FIRST", "ROSTERSTATUS", "FROM_YEAR", "TO_YEAR", "PLAYERCODE"],
+				"rowSet": [[201566, "Westbrook, Russell", 1, "2008", "2015", "russell_westbrook"]]}]}`))
+		}
+	}))
+	defer server.Close()
+
+	requester := Requester{
+		Domain: strings.TrimPrefix(server.URL, "http://"),
+		Retry: &RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			Multiplier:     2,
+		},
+	}
+
+	var resp CommonAllPlayersResponse
+	if err := requester.Request(context.Background(), "commonallplayers", CommonAllPlayersParams{}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %v", attempts)
+	}
+	if len(resp.CommonAllPlayers) != 1 {
+		t.Errorf("expected 1 player, got %+v", resp.CommonAllPlayers)
+	}
+}
+
+func TestRequestDoesNotRetryPermanentFailures(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	requester := Requester{
+		Domain: strings.TrimPrefix(server.URL, "http://"),
+		Retry:  &RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond},
+	}
+
+	var resp CommonAllPlayersResponse
+	if err := requester.Request(context.Background(), "commonallplayers", CommonAllPlayersParams{}, &resp); err == nil {
+		t.Fatal("expected an error for a 400 response")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %v", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2015, time.April, 27, 20, 0, 0, 0, time.UTC)
+	testCases := map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"Mon, 27 Apr 2015 20:00:30 GMT": 30 * time.Second,
+		"soon":                          0,
+	}
+	for value, expected := range testCases {
+		if got := parseRetryAfter(value, now); got != expected {
+			t.Errorf("parseRetryAfter(%q) = %v, expected %v", value, got, expected)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package endpoints
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DecodeMode determines how the decoder treats responses whose result sets
+// don't match the structs they're decoded into.
+type DecodeMode int
+
+const (
+	// DecodeDefault fails if a result set with rows is missing a header
+	// that a field expects, and ignores extra columns.
+	DecodeDefault DecodeMode = iota
+	// DecodeLenient leaves fields whose headers are missing at their zero
+	// value, and reports the missing headers as SchemaDrift.
+	DecodeLenient
+	// DecodeStrict reports missing headers, missing result sets and
+	// columns that no field decodes as SchemaDrift, and fails if there is
+	// any.
+	DecodeStrict
+)
+
+// SchemaDrift describes how a response differs from the struct it was
+// decoded into, so that upstream changes to stats.nba.com can be caught
+// before they cause outages. As an error, it matches ErrSchemaMismatch.
+type SchemaDrift struct {
+	// MissingResultSets are the result sets the struct expects that
+	// weren't in the response.
+	MissingResultSets []string
+	// ResultSets describes the result sets whose headers differ.
+	ResultSets []*ResultSetDrift
+}
+
+// ResultSetDrift describes how a result set's headers differ from the
+// struct it was decoded into.
+type ResultSetDrift struct {
+	Name string
+	// MissingHeaders are the headers fields expect that the result set
+	// doesn't have.
+	MissingHeaders []string
+	// UnknownHeaders are the result set's headers that no field decodes.
+	// They're only reported in DecodeStrict mode.
+	UnknownHeaders []string
+}
+
+func (d *SchemaDrift) Error() string {
+	var problems []string
+	if len(d.MissingResultSets) > 0 {
+		problems = append(problems, fmt.Sprintf("missing result sets %v", d.MissingResultSets))
+	}
+	for _, rsd := range d.ResultSets {
+		problems = append(problems, rsd.String())
+	}
+	return "schema drift: " + strings.Join(problems, "; ")
+}
+
+// Is reports whether target is ErrSchemaMismatch.
+func (d *SchemaDrift) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+func (d *SchemaDrift) empty() bool {
+	return len(d.MissingResultSets) == 0 && len(d.ResultSets) == 0
+}
+
+// orNil returns nil in place of an empty SchemaDrift.
+func (d *SchemaDrift) orNil() *SchemaDrift {
+	if d.empty() {
+		return nil
+	}
+	return d
+}
+
+func (d *ResultSetDrift) String() string {
+	var problems []string
+	if len(d.MissingHeaders) > 0 {
+		problems = append(problems, fmt.Sprintf("missing headers %v", d.MissingHeaders))
+	}
+	if len(d.UnknownHeaders) > 0 {
+		problems = append(problems, fmt.Sprintf("unknown headers %v", d.UnknownHeaders))
+	}
+	return fmt.Sprintf("%s: %s", d.Name, strings.Join(problems, ", "))
+}
+
+func (d *ResultSetDrift) empty() bool {
+	return len(d.MissingHeaders) == 0 && len(d.UnknownHeaders) == 0
+}
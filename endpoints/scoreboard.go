@@ -1,13 +1,17 @@
 package endpoints
 
-import "github.com/jbowens/nbagame/data"
+import (
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
 
 // ScoreboardParams defines parameters for a ScoreboardV2 request.
 // http://stats.nba.com/stats/scoreboardV2?DayOffset=0&LeagueID=00&gameDate=04%2F27%2F2015
 type ScoreboardParams struct {
-	LeagueID  string `json:"LeagueID"`
-	DayOffset int    `json:"DayOffset"`
-	GameDate  string `json:"gameDate"`
+	LeagueID  string    `json:"LeagueID"`
+	DayOffset int       `json:"DayOffset"`
+	GameDate  time.Time `json:"gameDate"`
 }
 
 // ScoreboardResponse is the type for all result sets returned by the
@@ -17,8 +21,8 @@ type ScoreboardResponse struct {
 	LineScore   []*LineScoreboardRow `nbagame:"LineScore"`
 	LastMeeting []*LastMeetingRow    `nbagame:"LastMeeting"`
 
-	// TODO(jackson): Add support for EastConfStandingsByDay and
-	// WestConfStandingsByDay result sets.
+	EastConfStandingsByDay []*ConfStandingsByDayRow `nbagame:"EastConfStandingsByDay"`
+	WestConfStandingsByDay []*ConfStandingsByDayRow `nbagame:"WestConfStandingsByDay"`
 }
 
 // LineScoreboardRow represents the schema returned for 'LineScore' result
@@ -89,3 +93,59 @@ func (resp *ScoreboardResponse) ToData() (games []*data.Game, err error) {
 type GameHeaderRow struct {
 	GameSummaryRow
 }
+
+// Standings returns the standings of each conference as of the scoreboard's
+// date. The scoreboard doesn't include team names, streaks or records over
+// the last ten games, so TeamName, Streak and LastTen are left empty. Teams
+// are ranked within their conference in the order the scoreboard lists
+// them, which applies the league's tie-breakers.
+func (resp *ScoreboardResponse) Standings() []*data.Standing {
+	var standings []*data.Standing
+	for _, conference := range [][]*ConfStandingsByDayRow{resp.EastConfStandingsByDay, resp.WestConfStandingsByDay} {
+		var confStandings []*data.Standing
+		for _, row := range conference {
+			confStandings = append(confStandings, row.ToStanding())
+		}
+		for i, standing := range confStandings {
+			leader := confStandings[0]
+			standing.ConferenceRank = i + 1
+			standing.GamesBack = float64((leader.Wins-standing.Wins)+(standing.Losses-leader.Losses)) / 2
+		}
+		standings = append(standings, confStandings...)
+	}
+	return standings
+}
+
+// ConfStandingsByDayRow represents the schema returned for the
+// 'EastConfStandingsByDay' and 'WestConfStandingsByDay' result sets,
+// returned from the 'scoreboardV2' resource.
+type ConfStandingsByDayRow struct {
+	TeamID        int         `nbagame:"TEAM_ID"`
+	LeagueID      string      `nbagame:"LEAGUE_ID"`
+	SeasonID      string      `nbagame:"SEASON_ID"`
+	StandingsDate time.Time   `nbagame:"STANDINGSDATE,date"`
+	Conference    string      `nbagame:"CONFERENCE"`
+	Team          string      `nbagame:"TEAM"`
+	GamesPlayed   int         `nbagame:"G"`
+	Wins          int         `nbagame:"W"`
+	Losses        int         `nbagame:"L"`
+	WinPercentage float64     `nbagame:"W_PCT"`
+	HomeRecord    data.Record `nbagame:"HOME_RECORD,record"`
+	RoadRecord    data.Record `nbagame:"ROAD_RECORD,record"`
+}
+
+// ToStanding converts this row into a Standing data struct. The row's TEAM
+// is the team's city.
+func (r *ConfStandingsByDayRow) ToStanding() *data.Standing {
+	return &data.Standing{
+		TeamID:        r.TeamID,
+		TeamCity:      r.Team,
+		Conference:    r.Conference,
+		Date:          data.Date(r.StandingsDate),
+		Wins:          r.Wins,
+		Losses:        r.Losses,
+		WinPercentage: r.WinPercentage,
+		Home:          r.HomeRecord,
+		Road:          r.RoadRecord,
+	}
+}
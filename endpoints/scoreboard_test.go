@@ -0,0 +1,78 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestScoreboard(t *testing.T) {
+	var resp ScoreboardResponse
+	if err := testRequester.Request(context.Background(), "scoreboardV2", &ScoreboardParams{
+		LeagueID:  "00",
+		DayOffset: 0,
+		GameDate:  time.Date(2015, time.April, 13, 0, 0, 0, 0, EastCoast),
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	games, err := resp.ToData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 3 {
+		t.Fatalf("expected 3 games, got %v", len(games))
+	}
+	if len(resp.LineScore) != 2*len(games) {
+		t.Errorf("expected a line score per team, got %v", len(resp.LineScore))
+	}
+	for _, game := range games {
+		if game.Season != "2014-15" || game.Status != data.Final || game.Playoffs {
+			t.Errorf("unexpected game: %+v", game)
+		}
+		if game.LastMeetingGameID == "" {
+			t.Errorf("expected game %v to have a last meeting", game.ID)
+		}
+	}
+	if games[2].ID != "0021401185" || games[2].HomeTeamID != 1610612737 {
+		t.Errorf("expected the Bulls at the Hawks, got %+v", games[2])
+	}
+}
+
+func TestScoreboardStandings(t *testing.T) {
+	var resp ScoreboardResponse
+	if err := testRequester.Request(context.Background(), "scoreboardV2", &ScoreboardParams{
+		LeagueID:  "00",
+		DayOffset: 0,
+		GameDate:  time.Date(2015, time.April, 13, 0, 0, 0, 0, EastCoast),
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	standings := resp.Standings()
+	if len(standings) != 30 {
+		t.Fatalf("expected 30 teams, got %v", len(standings))
+	}
+	leaders := map[string]*data.Standing{}
+	for _, standing := range standings {
+		if time.Time(standing.Date).Format("01/02/2006") != "04/13/2015" {
+			t.Errorf("expected standings as of 04/13/2015, got %v", standing.Date)
+		}
+		if standing.Home.Wins+standing.Road.Wins != standing.Wins || standing.Home.Losses+standing.Road.Losses != standing.Losses {
+			t.Errorf("expected home and road records to add up, got %+v", standing)
+		}
+		if standing.ConferenceRank == 1 {
+			leaders[standing.Conference] = standing
+			if standing.GamesBack != 0 {
+				t.Errorf("expected the leader to be 0 games back, got %v", standing.GamesBack)
+			}
+		} else if standing.GamesBack <= 0 {
+			t.Errorf("expected %v to be behind, got %v", standing.TeamCity, standing.GamesBack)
+		}
+	}
+	if leaders["East"] == nil || leaders["East"].TeamID != 1610612737 || leaders["West"] == nil {
+		t.Errorf("expected the Hawks to lead the East, got %+v", leaders)
+	}
+}
@@ -1,5 +1,11 @@
 package endpoints
 
+import (
+	"strings"
+
+	"github.com/jbowens/nbagame/data"
+)
+
 // ShotChartDetailParams defines parameters for a shotchartdetail request.
 // http://stats.nba.com/stats/shotchartdetail?CFID=&CFPARAMS=&ContextFilter=&ContextMeasure=FGA&DateFrom=&DateTo=&GameID=&GameSegment=&LastNGames=0&LeagueID=00&Location=&MeasureType=Base&Month=0&OpponentTeamID=0&Outcome=&PORound=0&PaceAdjust=N&PerMode=PerGame&Period=0&PlayerID=2747&PlusMinus=N&Position=&Rank=N&RookieYear=&Season=2015-16&SeasonSegment=&SeasonType=Regular+Season&ShotClockRange=&TeamID=0&VsConference=&VsDivision=
 type ShotChartDetailParams struct {
@@ -40,7 +46,23 @@ type ShotChartDetailParams struct {
 // It also implements sort.Interface for sorting shot details by when they happened
 // in the game.
 type ShotChartDetailResponse struct {
-	ShotDetails []*ShotDetailRow `nbagame:"Shot_Chart_Detail"`
+	ShotDetails    []*ShotDetailRow        `nbagame:"Shot_Chart_Detail"`
+	LeagueAverages []*ShotLeagueAverageRow `nbagame:"LeagueAverages"`
+}
+
+// ToData returns a nbagame.data representation of this response.
+func (r *ShotChartDetailResponse) ToData() *data.ShotChart {
+	chart := &data.ShotChart{
+		Shots:          make([]*data.ShotAttempt, 0, len(r.ShotDetails)),
+		LeagueAverages: make([]*data.ShotZoneAverage, 0, len(r.LeagueAverages)),
+	}
+	for _, row := range r.ShotDetails {
+		chart.Shots = append(chart.Shots, row.ToShotAttempt())
+	}
+	for _, row := range r.LeagueAverages {
+		chart.LeagueAverages = append(chart.LeagueAverages, row.ToShotZoneAverage())
+	}
+	return chart
 }
 
 func (r *ShotChartDetailResponse) Len() int {
@@ -77,6 +99,7 @@ type ShotDetailRow struct {
 	SecondsRemaining int    `nbagame:"SECONDS_REMAINING"`
 	EventType        string `nbagame:"EVENT_TYPE"`
 	ActionType       string `nbagame:"ACTION_TYPE"`
+	ShotType         string `nbagame:"SHOT_TYPE"`
 	ShotZoneBasic    string `nbagame:"SHOT_ZONE_BASIC"`
 	ShotZoneArea     string `nbagame:"SHOT_ZONE_AREA"`
 	ShotZoneRange    string `nbagame:"SHOT_ZONE_RANGE"`
@@ -86,3 +109,58 @@ type ShotDetailRow struct {
 	ShotAttempted    int    `nbagame:"SHOT_ATTEMPTED_FLAG"`
 	ShotMade         int    `nbagame:"SHOT_MADE_FLAG"`
 }
+
+// ToShotAttempt converts this row into a ShotAttempt data struct.
+func (r *ShotDetailRow) ToShotAttempt() *data.ShotAttempt {
+	pointsAttempted := 2
+	if strings.HasPrefix(r.ShotType, "3PT") {
+		pointsAttempted = 3
+	}
+	return &data.ShotAttempt{
+		GameID:           data.GameID(r.GameID),
+		GameEventID:      r.GameEventID,
+		PlayerID:         r.PlayerID,
+		PlayerName:       r.PlayerName,
+		TeamID:           r.TeamID,
+		TeamName:         r.TeamName,
+		Period:           r.Period,
+		SecondsRemaining: r.MinutesRemaining*60 + r.SecondsRemaining,
+		ActionType:       r.ActionType,
+		PointsAttempted:  pointsAttempted,
+		Made:             r.ShotMade == 1,
+		Distance:         r.ShotDistance,
+		LocationX:        r.LocationX,
+		LocationY:        r.LocationY,
+		ShotZone: data.ShotZone{
+			Basic: r.ShotZoneBasic,
+			Area:  r.ShotZoneArea,
+			Range: r.ShotZoneRange,
+		},
+	}
+}
+
+// ShotLeagueAverageRow represents the schema returned for 'LeagueAverages'
+// result sets, returned from the 'shotchartdetail' resource.
+type ShotLeagueAverageRow struct {
+	GridType            string  `nbagame:"GRID_TYPE"`
+	ShotZoneBasic       string  `nbagame:"SHOT_ZONE_BASIC"`
+	ShotZoneArea        string  `nbagame:"SHOT_ZONE_AREA"`
+	ShotZoneRange       string  `nbagame:"SHOT_ZONE_RANGE"`
+	FieldGoalsAttempted int     `nbagame:"FGA"`
+	FieldGoalsMade      int     `nbagame:"FGM"`
+	FieldGoalPercentage float64 `nbagame:"FG_PCT"`
+}
+
+// ToShotZoneAverage converts this row into a ShotZoneAverage data struct.
+func (r *ShotLeagueAverageRow) ToShotZoneAverage() *data.ShotZoneAverage {
+	return &data.ShotZoneAverage{
+		ShotZone: data.ShotZone{
+			Basic: r.ShotZoneBasic,
+			Area:  r.ShotZoneArea,
+			Range: r.ShotZoneRange,
+		},
+		FieldGoalsAttempted: r.FieldGoalsAttempted,
+		FieldGoalsMade:      r.FieldGoalsMade,
+		FieldGoalPercentage: r.FieldGoalPercentage,
+	}
+}
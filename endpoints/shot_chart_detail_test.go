@@ -1,7 +1,8 @@
 package endpoints
 
 import (
-	"fmt"
+	"context"
+	"sort"
 	"testing"
 )
 
@@ -20,14 +21,68 @@ func TestShotChartDetail(t *testing.T) {
 	}
 
 	var resp ShotChartDetailResponse
-	if err := DefaultRequester.Request("shotchartdetail", params, &resp); err != nil {
+	if err := testRequester.Request(context.Background(), "shotchartdetail", params, &resp); err != nil {
 		t.Fatal(err)
 	}
 	if len(resp.ShotDetails) == 0 {
 		t.Error("Empty response for boxscoresummary request.")
 	}
 
-	for _, shot := range resp.ShotDetails {
-		fmt.Printf("%v\t%v\t%v\n", shot.Period, shot.MinutesRemaining*60+shot.SecondsRemaining, shot.ShotMade)
+	sort.Sort(&resp)
+	var made int
+	for i, shot := range resp.ShotDetails {
+		if i > 0 && shot.Period < resp.ShotDetails[i-1].Period {
+			t.Errorf("expected shots to be sorted by period, got %v after %v",
+				shot.Period, resp.ShotDetails[i-1].Period)
+		}
+		if shot.PlayerID != 2406 || shot.ShotAttempted != 1 {
+			t.Errorf("unexpected shot: %+v", shot)
+		}
+		made += shot.ShotMade
+	}
+	if made != 4 {
+		t.Errorf("expected 4 made shots, got %v", made)
+	}
+}
+
+func TestShotChartDetailToData(t *testing.T) {
+	var resp ShotChartDetailResponse
+	if err := testRequester.Request(context.Background(), "shotchartdetail", ShotChartDetailParams{
+		ContextMeasure: "FGA",
+		EndPeriod:      10,
+		EndRange:       28800,
+		GameID:         "0021401203",
+		LeagueID:       "00",
+		PlayerID:       2406,
+		Season:         "2014-15",
+		SeasonType:     "Regular Season",
+		StartPeriod:    1,
+		TeamID:         1610612765,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	chart := resp.ToData()
+	if len(chart.Shots) != len(resp.ShotDetails) || len(chart.LeagueAverages) != 6 {
+		t.Fatalf("expected %v shots and 6 league averages, got %v and %v", len(resp.ShotDetails), len(chart.Shots), len(chart.LeagueAverages))
+	}
+
+	layup := chart.Shots[0]
+	if layup.ActionType != "Running Layup Shot" || !layup.Made || layup.PointsAttempted != 2 || layup.SecondsRemaining != 5*60+27 {
+		t.Errorf("unexpected shot: %+v", layup)
+	}
+	if layup.Distance != 2 || layup.LocationX != 11 || layup.LocationY != 17 || layup.Basic != "Restricted Area" || layup.Range != "Less Than 8 ft." {
+		t.Errorf("unexpected shot location: %+v", layup)
+	}
+
+	for _, shot := range chart.Shots {
+		average := chart.LeagueAverage(shot.ShotZone)
+		if average == nil {
+			t.Errorf("expected a league average for %+v", shot.ShotZone)
+			continue
+		}
+		if average.FieldGoalsMade > average.FieldGoalsAttempted || average.FieldGoalPercentage <= 0 {
+			t.Errorf("unexpected league average: %+v", average)
+		}
 	}
 }
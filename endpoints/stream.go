@@ -0,0 +1,257 @@
+package endpoints
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// streamResponse parses a response body like NewResponse, and decodes it
+// into v like Response.DecodeWith. Rather than buffering every row, it
+// streams the rows of the result sets v decodes straight into v. Those
+// result sets keep their name and headers in the returned Response, but
+// not their rows.
+func streamResponse(body []byte, v interface{}, mode DecodeMode) (*Response, *SchemaDrift, error) {
+	fields, err := resultSetFields(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := &streamer{
+		dec:     json.NewDecoder(bytes.NewReader(body)),
+		mode:    mode,
+		targets: make(map[string]resultSetField, len(fields)),
+		resp:    &Response{},
+		drift:   &SchemaDrift{},
+		nested:  make(map[string]interface{}),
+	}
+	for _, field := range fields {
+		s.targets[field.name] = field
+	}
+
+	if err := s.response(); err != nil {
+		return s.resp, s.drift.orNil(), badJSON(err)
+	}
+	if s.resp.ResultSets == nil && len(s.nested) > 0 {
+		// This is a v3 response, which can't be streamed.
+		s.resp.ResultSets = nestedResultSets(s.nested)
+		drift, err := s.resp.DecodeWith(v, mode)
+		return s.resp, drift, err
+	}
+	for _, field := range fields {
+		if mode != DecodeDefault && s.resp.ResultSetByName(field.name) == nil {
+			s.drift.MissingResultSets = append(s.drift.MissingResultSets, field.name)
+		}
+	}
+	if mode == DecodeStrict && !s.drift.empty() {
+		return s.resp, s.drift, s.drift
+	}
+	return s.resp, s.drift.orNil(), nil
+}
+
+// streamer walks the tokens of a response body.
+type streamer struct {
+	dec     *json.Decoder
+	mode    DecodeMode
+	targets map[string]resultSetField
+	resp    *Response
+	drift   *SchemaDrift
+	// nested holds the members of a v3 response.
+	nested map[string]interface{}
+}
+
+func (s *streamer) response() error {
+	return s.object(func(key string) error {
+		switch key {
+		case "resource":
+			return s.dec.Decode(&s.resp.Resource)
+		case "parameters":
+			return s.dec.Decode(&s.resp.Parameters)
+		case "resultSets", "resultSet":
+			return s.resultSets()
+		}
+		// Keep anything else, in case this is a v3 response.
+		var value interface{}
+		if err := s.dec.Decode(&value); err != nil {
+			return err
+		}
+		s.nested[key] = value
+		return nil
+	})
+}
+
+// resultSets streams either a list of result sets or a single one.
+func (s *streamer) resultSets() error {
+	token, err := s.dec.Token()
+	if err != nil || token == nil {
+		return err
+	}
+	switch token {
+	case json.Delim('['):
+		for s.dec.More() {
+			if err := s.resultSet(false); err != nil {
+				return err
+			}
+		}
+		return s.close()
+	case json.Delim('{'):
+		return s.resultSet(true)
+	}
+	return ErrBadResponse(fmt.Sprintf("expected result sets, got `%v`", token))
+}
+
+// resultSet streams a result set object. If opened is true, its opening
+// delimiter has already been consumed.
+func (s *streamer) resultSet(opened bool) error {
+	if !opened {
+		if ok, err := s.open('{'); !ok || err != nil {
+			return err
+		}
+	}
+
+	rs := &ResultSet{}
+	s.resp.ResultSets = append(s.resp.ResultSets, rs)
+	var decoder *rowDecoder
+	err := s.members(func(key string) error {
+		switch key {
+		case "name":
+			return s.dec.Decode(&rs.Name)
+		case "headers":
+			return s.dec.Decode((*resultSetHeaders)(&rs.Headers))
+		case "rowSet":
+			target, ok := s.targets[rs.Name]
+			if !ok || rs.Headers == nil {
+				// Buffer the rows if they aren't decoded into v, or if they
+				// came before the headers.
+				return s.dec.Decode(&rs.RowSet)
+			}
+			var err error
+			decoder, err = rs.rowDecoder(target.value.Addr().Interface(), s.mode)
+			if err != nil {
+				return err
+			}
+			return s.rows(rs, decoder)
+		}
+		return s.skip()
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.close(); err != nil {
+		return err
+	}
+
+	if target, ok := s.targets[rs.Name]; ok && decoder == nil {
+		// The rows were buffered, so decode them now.
+		rsDrift, err := rs.DecodeWith(target.value.Addr().Interface(), s.mode)
+		if rsDrift != nil {
+			s.drift.ResultSets = append(s.drift.ResultSets, rsDrift)
+		}
+		return err
+	}
+	return nil
+}
+
+// rows streams the rows of a result set into decoder.
+func (s *streamer) rows(rs *ResultSet, decoder *rowDecoder) error {
+	if decoder.drift != nil {
+		s.drift.ResultSets = append(s.drift.ResultSets, decoder.drift)
+	}
+	statuses := newStatusColumns(rs.makeHeaderMap())
+
+	var row []interface{}
+	return s.array(func() error {
+		row = row[:0]
+		if err := s.dec.Decode(&row); err != nil {
+			return err
+		}
+		if err := decoder.decode(row); err != nil {
+			return err
+		}
+		rs.streamedRows++
+		if status, ok := statuses.status(row); ok {
+			s.resp.statuses = append(s.resp.statuses, status)
+		}
+		return nil
+	})
+}
+
+// object calls value for the key of each of an object's members, which it
+// must consume. A null object is skipped.
+func (s *streamer) object(value func(key string) error) error {
+	if ok, err := s.open('{'); !ok || err != nil {
+		return err
+	}
+	if err := s.members(value); err != nil {
+		return err
+	}
+	return s.close()
+}
+
+// members calls value for the key of each of the members of an object
+// whose opening delimiter has been consumed.
+func (s *streamer) members(value func(key string) error) error {
+	for s.dec.More() {
+		token, err := s.dec.Token()
+		if err != nil {
+			return err
+		}
+		if err := value(token.(string)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// array calls value for each of an array's values, which it must consume.
+// A null array is skipped.
+func (s *streamer) array(value func() error) error {
+	if ok, err := s.open('['); !ok || err != nil {
+		return err
+	}
+	for s.dec.More() {
+		if err := value(); err != nil {
+			return err
+		}
+	}
+	return s.close()
+}
+
+// open consumes the opening delimiter of an object or array, reporting
+// false if the value is null instead.
+func (s *streamer) open(delim json.Delim) (bool, error) {
+	token, err := s.dec.Token()
+	if err != nil {
+		return false, err
+	}
+	if token == nil {
+		return false, nil
+	}
+	if token != delim {
+		return false, ErrBadResponse(fmt.Sprintf("expected `%v`, got `%v`", delim, token))
+	}
+	return true, nil
+}
+
+// close consumes the closing delimiter of an object or array.
+func (s *streamer) close() error {
+	_, err := s.dec.Token()
+	return err
+}
+
+// skip consumes a value that isn't needed.
+func (s *streamer) skip() error {
+	var value json.RawMessage
+	return s.dec.Decode(&value)
+}
+
+// badJSON returns errors for malformed JSON as ErrBadResponse.
+func badJSON(err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrBadResponse(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package endpoints
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStreamMatchesBuffered(t *testing.T) {
+	for _, bench := range benchmarkResponses {
+		paths, err := filepath.Glob(filepath.Join("testdata", bench.endpoint, "*.json"))
+		if err != nil || len(paths) == 0 {
+			t.Fatalf("no recorded responses for %s", bench.endpoint)
+		}
+		body, err := ioutil.ReadFile(paths[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		respType := reflect.TypeOf(bench.resp)
+		buffered := reflect.New(respType).Interface()
+		resp, err := NewResponse(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := resp.Decode(buffered); err != nil {
+			t.Fatal(err)
+		}
+
+		streamed := reflect.New(respType).Interface()
+		streamedResp, drift, err := streamResponse(body, streamed, DecodeDefault)
+		if err != nil || drift != nil {
+			t.Fatalf("%s: %v, %v", bench.endpoint, drift, err)
+		}
+		if !reflect.DeepEqual(buffered, streamed) {
+			t.Errorf("%s: streamed response doesn't match buffered response", bench.endpoint)
+		}
+		if !reflect.DeepEqual(gameStatuses(resp), gameStatuses(streamedResp)) {
+			t.Errorf("%s: expected game statuses %v, got %v", bench.endpoint, gameStatuses(resp), gameStatuses(streamedResp))
+		}
+		for _, rs := range resp.ResultSets {
+			view := ResponseView{response: streamedResp}
+			if view.Rows(rs.Name) != len(rs.RowSet) {
+				t.Errorf("%s: expected %v rows in %s, got %v", bench.endpoint, len(rs.RowSet), rs.Name, view.Rows(rs.Name))
+			}
+		}
+	}
+}
+
+func TestStreamRowsBeforeHeaders(t *testing.T) {
+	body := []byte(`{"resultSets": [{"rowSet": [[20, "Delonte West"]], "name": "Players",
+		"headers": ["PLAYER_ID", "NAME"]}, {"name": "Coaches", "headers": null, "rowSet": null}]}`)
+
+	var resp testPlayerResponse
+	if _, _, err := streamResponse(body, &resp, DecodeDefault); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.Players, []*testPlayer{{20, "Delonte West"}}) {
+		t.Errorf("unexpected players: %+v", resp.Players)
+	}
+}
+
+func TestStreamBadJSON(t *testing.T) {
+	for _, body := range []string{`<html>Access Denied</html>`, `{"resultSets": [{"name": "Players", "rowSet": [[20,`, `[]`} {
+		var resp testPlayerResponse
+		if _, _, err := streamResponse([]byte(body), &resp, DecodeDefault); err == nil {
+			t.Errorf("expected an error for %q", body)
+		} else if _, ok := err.(ErrBadResponse); !ok {
+			t.Errorf("expected a bad response for %q, got %#v", body, err)
+		}
+	}
+}
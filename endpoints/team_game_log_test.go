@@ -0,0 +1,38 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+func TestTeamGameLog(t *testing.T) {
+	var resp TeamGameLogResponse
+	if err := testRequester.Request(context.Background(), "teamgamelog", &TeamGameLogParams{
+		LeagueID:   "00",
+		Season:     "2014-15",
+		SeasonType: "Regular Season",
+		TeamID:     1610612737,
+	}, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.TeamGameLog) != 82 {
+		t.Fatalf("expected 82 games, got %v", len(resp.TeamGameLog))
+	}
+	for _, game := range resp.TeamGameLog {
+		if game.TeamID != 1610612737 {
+			t.Errorf("expected a Hawks game, got %+v", game)
+		}
+		if id := data.GameID(game.GameID); id.IsPlayoff() || id.Season() != "2014-15" {
+			t.Errorf("expected a 2014-15 regular season game, got %v", id)
+		}
+		if game.WinOrLoss != "W" && game.WinOrLoss != "L" {
+			t.Errorf("unexpected result %q", game.WinOrLoss)
+		}
+		if game.Rebounds != game.OffensiveRebounds+game.DefensiveRebounds {
+			t.Errorf("expected rebounds to add up, got %+v", game)
+		}
+	}
+}
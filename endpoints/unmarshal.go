@@ -0,0 +1,200 @@
+package endpoints
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+// ResultSetValueUnmarshaler is implemented by types that decode themselves
+// from a result set cell. UnmarshalResultSetValue is given the raw value of
+// the cell as decoded from JSON, ie. a string, float64 or bool. It isn't
+// called for null cells, which leave the field at its zero value.
+type ResultSetValueUnmarshaler interface {
+	UnmarshalResultSetValue(value interface{}) error
+}
+
+var resultSetValueUnmarshalerType = reflect.TypeOf((*ResultSetValueUnmarshaler)(nil)).Elem()
+
+// valueConverter converts cells for a tag option.
+type valueConverter struct {
+	// decode converts a cell before it's set on a field. It returns a value
+	// that's then converted to the field's type like any other cell, or nil
+	// for an empty cell, leaving the field at its zero value.
+	decode func(value interface{}) (interface{}, error)
+	// encode converts a field's value, which isn't a pointer, back into a
+	// cell.
+	encode func(value reflect.Value) interface{}
+}
+
+// valueConverters are the tag options that convert cells, ex.
+// `nbagame:"MIN,seconds"`.
+var valueConverters = map[string]valueConverter{
+	// seconds converts minutes played, either "MM:SS" or a number of
+	// minutes, to an int number of seconds.
+	"seconds": {convertSeconds, encodeSeconds},
+	// inches converts a height like "6-3" to an int number of inches.
+	"inches": {convertInches, encodeInches},
+	// date converts an ISO date, ex. "2015-04-13T00:00:00", or a date like
+	// "APR 13, 2015" or "04/13/2015" to a time.Time in Eastern time. Fields may be a
+	// time.Time or a data.Date.
+	"date": {convertDate, encodeDate},
+	// flag converts a "Y" or "N" flag to a bool.
+	"flag": {convertFlag, encodeFlag},
+	// score converts a score like "94 - 97" to a data.Score.
+	"score": {convertScore, encodeScore},
+	// record converts a record like "30-11" to a data.Record.
+	"record": {convertRecord, encodeRecord},
+}
+
+// resultSetDateFormats are the formats of dates in result sets.
+var resultSetDateFormats = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	"Jan 2, 2006",
+	"01/02/2006",
+}
+
+// parseTag splits a field's tag into its header and option.
+func parseTag(tag string) (header, option string) {
+	header, option, _ = strings.Cut(tag, ",")
+	return header, option
+}
+
+func convertSeconds(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case float64:
+		return int(math.Round(v * 60)), nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		minutes, seconds, _ := strings.Cut(v, ":")
+		m, err := strconv.ParseFloat(minutes, 64)
+		if err != nil {
+			return nil, err
+		}
+		if seconds == "" {
+			return int(math.Round(m * 60)), nil
+		}
+		s, err := strconv.Atoi(seconds)
+		if err != nil {
+			return nil, err
+		}
+		return int(m)*60 + s, nil
+	}
+	return nil, fmt.Errorf("unexpected minutes `%v`", value)
+}
+
+func convertInches(value interface{}) (interface{}, error) {
+	height, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected height `%v`", value)
+	}
+	if height == "" {
+		return nil, nil
+	}
+	var feet, inches int
+	if _, err := fmt.Sscanf(height, "%d-%d", &feet, &inches); err != nil {
+		return nil, fmt.Errorf("unexpected height `%s`", height)
+	}
+	return feet*12 + inches, nil
+}
+
+func convertDate(value interface{}) (interface{}, error) {
+	date, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected date `%v`", value)
+	}
+	if date == "" {
+		return nil, nil
+	}
+	for _, format := range resultSetDateFormats {
+		if t, err := time.ParseInLocation(format, date, EastCoast); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected date `%s`", date)
+}
+
+func convertFlag(value interface{}) (interface{}, error) {
+	if b, ok := value.(bool); ok {
+		return b, nil
+	}
+	switch strings.ToUpper(fmt.Sprint(value)) {
+	case "Y":
+		return true, nil
+	case "N", "":
+		return false, nil
+	}
+	return nil, fmt.Errorf("unexpected flag `%v`", value)
+}
+
+func convertScore(value interface{}) (interface{}, error) {
+	score, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected score `%v`", value)
+	}
+	if score == "" {
+		return nil, nil
+	}
+	var home, visitor int
+	if _, err := fmt.Sscanf(score, "%d - %d", &home, &visitor); err != nil {
+		return nil, fmt.Errorf("unexpected score `%s`", score)
+	}
+	return data.Score{Home: home, Visitor: visitor}, nil
+}
+
+func convertRecord(value interface{}) (interface{}, error) {
+	record, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected record `%v`", value)
+	}
+	if record == "" {
+		return nil, nil
+	}
+	var wins, losses int
+	if _, err := fmt.Sscanf(record, "%d-%d", &wins, &losses); err != nil {
+		return nil, fmt.Errorf("unexpected record `%s`", record)
+	}
+	return data.Record{Wins: wins, Losses: losses}, nil
+}
+
+func encodeSeconds(value reflect.Value) interface{} {
+	seconds := value.Int()
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+func encodeInches(value reflect.Value) interface{} {
+	inches := value.Int()
+	return fmt.Sprintf("%d-%d", inches/12, inches%12)
+}
+
+func encodeDate(value reflect.Value) interface{} {
+	t := value.Convert(reflect.TypeOf(time.Time{})).Interface().(time.Time)
+	if t.IsZero() {
+		return nil
+	}
+	return t.In(EastCoast).Format(resultSetDateFormats[0])
+}
+
+func encodeFlag(value reflect.Value) interface{} {
+	if value.Bool() {
+		return "Y"
+	}
+	return "N"
+}
+
+func encodeScore(value reflect.Value) interface{} {
+	score := value.Interface().(data.Score)
+	return fmt.Sprintf("%d - %d", score.Home, score.Visitor)
+}
+
+func encodeRecord(value reflect.Value) interface{} {
+	return value.Interface().(data.Record).String()
+}
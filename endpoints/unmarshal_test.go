@@ -0,0 +1,119 @@
+package endpoints
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+type testPosition string
+
+func (p *testPosition) UnmarshalResultSetValue(value interface{}) error {
+	s, ok := value.(string)
+	if !ok {
+		return errors.New("position isn't a string")
+	}
+	*p = testPosition(strings.ToLower(s))
+	return nil
+}
+
+type testPlayerDetails struct {
+	Position       testPosition  `nbagame:"POSITION"`
+	BackupPosition *testPosition `nbagame:"BACKUP_POSITION"`
+	SecondsPlayed  int           `nbagame:"MIN,seconds"`
+	SeasonSeconds  int           `nbagame:"SEASON_MIN,seconds"`
+	Height         int           `nbagame:"HEIGHT,inches"`
+	Birthdate      data.Date     `nbagame:"BIRTHDATE,date"`
+	LastGame       *time.Time    `nbagame:"LAST_GAME_DATE,date"`
+	DLeague        bool          `nbagame:"DLEAGUE_FLAG,flag"`
+	Score          *data.Score   `nbagame:"SCORE,score"`
+	HomeRecord     data.Record   `nbagame:"HOME_RECORD,record"`
+}
+
+func TestDecodeCustomValues(t *testing.T) {
+	rs := &ResultSet{
+		Headers: []string{"POSITION", "BACKUP_POSITION", "MIN", "SEASON_MIN", "HEIGHT", "BIRTHDATE", "LAST_GAME_DATE", "DLEAGUE_FLAG", "SCORE", "HOME_RECORD"},
+		RowSet: [][]interface{}{
+			{"Guard", "Forward", "34:12", 2045.5, "6-3", "1988-10-04T00:00:00", "APR 13, 2015", "Y", "94 - 97", "30-11"},
+			{"Center", nil, "", nil, "", "", nil, "N", nil, ""},
+		},
+	}
+
+	var players []*testPlayerDetails
+	if err := rs.Decode(&players); err != nil {
+		t.Fatal(err)
+	}
+
+	p := players[0]
+	if p.Position != "guard" || p.BackupPosition == nil || *p.BackupPosition != "forward" {
+		t.Errorf("expected the positions to be unmarshaled, got %+v", p)
+	}
+	if p.SecondsPlayed != 34*60+12 || p.SeasonSeconds != 122730 || p.Height != 75 || !p.DLeague {
+		t.Errorf("unexpected values: %+v", p)
+	}
+	if p.Birthdate.String() != "10/04/1988" || p.LastGame == nil || p.LastGame.Format("2006-01-02") != "2015-04-13" {
+		t.Errorf("unexpected dates: %v, %v", p.Birthdate, p.LastGame)
+	}
+	if p.Score == nil || *p.Score != (data.Score{Home: 94, Visitor: 97}) {
+		t.Errorf("unexpected score: %+v", p.Score)
+	}
+	if p.HomeRecord != (data.Record{Wins: 30, Losses: 11}) {
+		t.Errorf("unexpected record: %+v", p.HomeRecord)
+	}
+
+	empty := players[1]
+	if empty.BackupPosition != nil || empty.SecondsPlayed != 0 || empty.Height != 0 || empty.LastGame != nil || empty.Score != nil || empty.DLeague || empty.HomeRecord != (data.Record{}) {
+		t.Errorf("expected empty cells to leave zero values, got %+v", empty)
+	}
+}
+
+func TestDecodeBoolFlagsAndUnpaddedDates(t *testing.T) {
+	rs := &ResultSet{
+		Headers: []string{"POSITION", "BIRTHDATE", "DLEAGUE_FLAG"},
+		RowSet: [][]interface{}{
+			{"Guard", "APR 3, 2015", true},
+			{"Center", "Apr 03, 2015", false},
+		},
+	}
+
+	var players []*testPlayerDetails
+	if _, err := rs.DecodeWith(&players, DecodeLenient); err != nil {
+		t.Fatal(err)
+	}
+	if !players[0].DLeague || players[1].DLeague {
+		t.Errorf("expected boolean flags to decode, got %v and %v", players[0].DLeague, players[1].DLeague)
+	}
+	for _, p := range players {
+		if p.Birthdate.String() != "04/03/2015" {
+			t.Errorf("expected April 3rd, 2015, got %v", p.Birthdate)
+		}
+	}
+}
+
+func TestDecodeCustomValueErrors(t *testing.T) {
+	tests := []struct {
+		header string
+		cell   interface{}
+	}{
+		{"POSITION", 4.0},
+		{"MIN", "DNP"},
+		{"HEIGHT", "tall"},
+		{"BIRTHDATE", "yesterday"},
+		{"DLEAGUE_FLAG", "maybe"},
+		{"SCORE", "94"},
+		{"HOME_RECORD", "30 and 11"},
+	}
+	for _, test := range tests {
+		rs := &ResultSet{
+			Headers: []string{test.header},
+			RowSet:  [][]interface{}{{test.cell}},
+		}
+		var players []*testPlayerDetails
+		if _, err := rs.DecodeWith(&players, DecodeLenient); !errors.Is(err, ErrSchemaMismatch) {
+			t.Errorf("%s: expected a bad response for `%v`, got %v", test.header, test.cell, err)
+		}
+	}
+}
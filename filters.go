@@ -0,0 +1,176 @@
+package nbagame
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+	"github.com/jbowens/nbagame/endpoints"
+)
+
+// GameLogFilter narrows down the games included in a game log. The zero
+// value includes every regular season game.
+type GameLogFilter struct {
+	// SeasonType is the part of the season to include. It defaults to the
+	// regular season.
+	SeasonType data.SeasonType
+	// DateFrom and DateTo, if set, only include games played on or between
+	// them.
+	DateFrom time.Time
+	DateTo   time.Time
+}
+
+func (f GameLogFilter) seasonType() string {
+	if f.SeasonType == "" {
+		return data.RegularSeason.String()
+	}
+	return f.SeasonType.String()
+}
+
+// ShotChartFilter narrows down the shots included in a shot chart to those
+// taken by a player, a team or in a game. The zero value includes every shot
+// in the current regular season.
+type ShotChartFilter struct {
+	PlayerID int
+	TeamID   int
+	GameID   data.GameID
+	// OpponentTeamID, if set, only includes shots against the team.
+	OpponentTeamID int
+	// Period, if set, only includes shots from the period.
+	Period int
+	// Season defaults to the season of GameID if it's set, or else the
+	// current season.
+	Season data.Season
+	// SeasonType defaults to the part of the season GameID was played in if
+	// it's set, or else the regular season.
+	SeasonType data.SeasonType
+}
+
+func (f ShotChartFilter) season() data.Season {
+	switch {
+	case f.Season != "":
+		return f.Season
+	case f.GameID != "":
+		return f.GameID.Season()
+	}
+	return data.CurrentSeason
+}
+
+func (f ShotChartFilter) seasonType() string {
+	switch {
+	case f.SeasonType != "":
+		return f.SeasonType.String()
+	case f.GameID.IsPlayoff():
+		return data.Playoffs.String()
+	}
+	return data.RegularSeason.String()
+}
+
+// LeagueStatsFilter selects the stats reported by the league dashboards, and
+// narrows down the games they're aggregated over. The zero value reports
+// per game averages of the traditional stats over the current regular
+// season.
+type LeagueStatsFilter struct {
+	// Season defaults to the current season.
+	Season data.Season
+	// SeasonType defaults to the regular season.
+	SeasonType data.SeasonType
+	// MeasureType is the family of stats to report, either MeasureBase or
+	// MeasureAdvanced. It defaults to MeasureBase, and any other measure
+	// type is rejected.
+	MeasureType data.MeasureType
+	// PerMode defaults to per game averages.
+	PerMode data.PerMode
+
+	// LastNGames, if set, only includes each team's most recent games.
+	LastNGames int
+	// Month, if set, only includes games played in the given month of the
+	// season, where 1 is October.
+	Month int
+	// OpponentTeamID, if set, only includes games against the team.
+	OpponentTeamID int
+	// Location, if set, only includes home or road games.
+	Location data.Location
+	// Outcome, if set, only includes wins or losses.
+	Outcome data.Outcome
+	// SeasonSegment, if set, only includes games played before or after the
+	// All-Star break.
+	SeasonSegment data.SeasonSegment
+	// DateFrom and DateTo, if set, only include games played on or between
+	// them.
+	DateFrom time.Time
+	DateTo   time.Time
+	// VsConference and VsDivision, if set, only include games against
+	// teams in the conference or division.
+	VsConference data.Conference
+	VsDivision   data.Division
+}
+
+func (f LeagueStatsFilter) params() (endpoints.LeagueDashPlayerStatsParams, error) {
+	measureType := f.measureType()
+	if measureType != data.MeasureBase && measureType != data.MeasureAdvanced {
+		return endpoints.LeagueDashPlayerStatsParams{}, fmt.Errorf("nbagame: unsupported measure type %q", measureType)
+	}
+	params := endpoints.LeagueDashPlayerStatsParams{
+		LeagueID:       "00",
+		Season:         data.CurrentSeason.String(),
+		SeasonType:     data.RegularSeason.String(),
+		MeasureType:    measureType.String(),
+		PerMode:        data.PerGame.String(),
+		LastNGames:     f.LastNGames,
+		Month:          f.Month,
+		OpponentTeamID: f.OpponentTeamID,
+		Location:       f.Location.String(),
+		Outcome:        f.Outcome.String(),
+		SeasonSegment:  f.SeasonSegment.String(),
+		DateFrom:       f.DateFrom,
+		DateTo:         f.DateTo,
+		VsConference:   f.VsConference.String(),
+		VsDivision:     f.VsDivision.String(),
+	}
+	if f.Season != "" {
+		params.Season = f.Season.String()
+	}
+	if f.SeasonType != "" {
+		params.SeasonType = f.SeasonType.String()
+	}
+	if f.PerMode != "" {
+		params.PerMode = f.PerMode.String()
+	}
+	return params, nil
+}
+
+func (f LeagueStatsFilter) measureType() data.MeasureType {
+	if f.MeasureType == "" {
+		return data.MeasureBase
+	}
+	return f.MeasureType
+}
+
+// DraftHistoryFilter narrows down the picks included in the draft history.
+// The zero value includes every pick of every draft.
+type DraftHistoryFilter struct {
+	// Year, if set, only includes picks from the draft held that year, ex.
+	// 2014 for the draft before the 2014-15 season.
+	Year int
+	// Round, if set, only includes picks from the round.
+	Round int
+	// TeamID, if set, only includes the team's picks.
+	TeamID int
+	// College, if set, only includes players picked out of the college.
+	College string
+}
+
+func (f DraftHistoryFilter) params() *endpoints.DraftHistoryParams {
+	params := &endpoints.DraftHistoryParams{
+		LeagueID: "00",
+		RoundNum: f.Round,
+		TeamID:   f.TeamID,
+		College:  f.College,
+	}
+	if f.Year != 0 {
+		params.Season = strconv.Itoa(f.Year)
+	}
+	return params
+}
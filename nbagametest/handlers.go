@@ -0,0 +1,573 @@
+package nbagametest
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+	"github.com/jbowens/nbagame/endpoints"
+)
+
+const (
+	leagueID       = "00"
+	dateTimeFormat = "2006-01-02T15:04:05"
+)
+
+var (
+	franchiseHistoryHeaders = []string{"LEAGUE_ID", "TEAM_ID", "TEAM_CITY", "TEAM_NAME", "START_YEAR",
+		"END_YEAR", "YEARS", "GAMES", "WINS", "LOSSES", "WIN_PCT", "PO_APPEARANCES", "DIV_TITLES",
+		"CONF_TITLES", "LEAGUE_TITLES"}
+	commonAllPlayersHeaders = []string{"PERSON_ID", "DISPLAY_LAST_COMMA_FIRST", "DISPLAY_FIRST_LAST",
+		"ROSTERSTATUS", "FROM_YEAR", "TO_YEAR", "PLAYERCODE", "TEAM_ID", "TEAM_CITY", "TEAM_NAME",
+		"TEAM_ABBREVIATION", "TEAM_CODE", "GAMES_PLAYED_FLAG"}
+	gameSummaryHeaders = []string{"GAME_DATE_EST", "GAME_SEQUENCE", "GAME_ID", "GAME_STATUS_ID",
+		"GAME_STATUS_TEXT", "GAMECODE", "HOME_TEAM_ID", "VISITOR_TEAM_ID", "SEASON", "LIVE_PERIOD",
+		"LIVE_PC_TIME", "NATL_TV_BROADCASTER_ABBREVIATION", "LIVE_PERIOD_TIME_BCAST", "WH_STATUS"}
+	otherStatsHeaders = []string{"LEAGUE_ID", "TEAM_ID", "TEAM_ABBREVIATION", "TEAM_CITY", "PTS_PAINT",
+		"PTS_2ND_CHANCE", "PTS_FB", "LARGEST_LEAD", "LEAD_CHANGES", "TIMES_TIED"}
+	officialsHeaders       = []string{"OFFICIAL_ID", "FIRST_NAME", "LAST_NAME", "JERSEY_NUM"}
+	inactivePlayersHeaders = []string{"PLAYER_ID", "FIRST_NAME", "LAST_NAME", "JERSEY_NUM", "TEAM_ID",
+		"TEAM_CITY", "TEAM_NAME", "TEAM_ABBREVIATION"}
+	gameInfoHeaders  = []string{"GAME_DATE", "ATTENDANCE", "GAME_TIME"}
+	lineScoreHeaders = []string{"GAME_DATE_EST", "GAME_SEQUENCE", "GAME_ID", "TEAM_ID",
+		"TEAM_ABBREVIATION", "TEAM_CITY_NAME", "TEAM_NICKNAME", "TEAM_WINS_LOSSES", "PTS_QTR1",
+		"PTS_QTR2", "PTS_QTR3", "PTS_QTR4", "PTS_OT1", "PTS_OT2", "PTS_OT3", "PTS_OT4", "PTS_OT5",
+		"PTS_OT6", "PTS_OT7", "PTS_OT8", "PTS_OT9", "PTS_OT10", "PTS"}
+	lineScoreboardHeaders = append(append([]string(nil), lineScoreHeaders...),
+		"PG_PCT", "PT_PCT", "FG3_PCT", "AST", "REB", "TOV")
+	lastMeetingHeaders = []string{"GAME_ID", "LAST_GAME_ID", "LAST_GAME_DATE_EST",
+		"LAST_GAME_HOME_TEAM_ID", "LAST_GAME_HOME_TEAM_CITY", "LAST_GAME_HOME_TEAM_NAME",
+		"LAST_GAME_HOME_TEAM_ABBREVIATION", "LAST_GAME_HOME_TEAM_POINTS", "LAST_GAME_VISITOR_TEAM_ID",
+		"LAST_GAME_VISITOR_TEAM_CITY", "LAST_GAME_VISITOR_TEAM_NAME", "LAST_GAME_VISITOR_TEAM_CITY1",
+		"LAST_GAME_VISITOR_TEAM_POINTS"}
+	seasonSeriesHeaders = []string{"GAME_ID", "HOME_TEAM_ID", "VISITOR_TEAM_ID", "GAME_DATE_EST",
+		"HOME_TEAM_WINS", "HOME_TEAM_LOSSES", "SERIES_LEADER"}
+	statHeaders = []string{"MIN", "FGM", "FGA", "FG_PCT", "FG3M", "FG3A", "FG3_PCT", "FTM", "FTA",
+		"FT_PCT", "OREB", "DREB", "REB", "AST", "STL", "BLK", "TO", "PF", "PTS", "PLUS_MINUS"}
+	playerStatsHeaders = append([]string{"GAME_ID", "TEAM_ID", "TEAM_ABBREVIATION", "TEAM_CITY",
+		"PLAYER_ID", "PLAYER_NAME", "START_POSITION", "COMMENT"}, statHeaders...)
+	teamStatsHeaders = append([]string{"GAME_ID", "TEAM_ID", "TEAM_NAME", "TEAM_ABBREVIATION",
+		"TEAM_CITY"}, statHeaders...)
+	playByPlayHeaders = []string{"GAME_ID", "EVENTNUM", "EVENTMSGTYPE", "EVENTMSGACTIONTYPE", "PERIOD",
+		"WCTIMESTRING", "PCTIMESTRING", "HOMEDESCRIPTION", "NEUTRALDESCRIPTION", "VISITORDESCRIPTION",
+		"SCORE", "SCOREMARGIN",
+		"PERSON1TYPE", "PLAYER1_ID", "PLAYER1_NAME", "PLAYER1_TEAM_ID", "PLAYER1_TEAM_CITY",
+		"PLAYER1_TEAM_NICKNAME", "PLAYER1_TEAM_ABBREVIATION",
+		"PERSON2TYPE", "PLAYER2_ID", "PLAYER2_NAME", "PLAYER2_TEAM_ID", "PLAYER2_TEAM_CITY",
+		"PLAYER2_TEAM_NICKNAME", "PLAYER2_TEAM_ABBREVIATION",
+		"PERSON3TYPE", "PLAYER3_ID", "PLAYER3_NAME", "PLAYER3_TEAM_ID", "PLAYER3_TEAM_CITY",
+		"PLAYER3_TEAM_NICKNAME", "PLAYER3_TEAM_ABBREVIATION"}
+	teamGameLogHeaders = []string{"Team_ID", "Game_ID", "GAME_DATE", "MATCHUP", "WL", "W", "L", "W_PCT",
+		"MIN", "FGM", "FGA", "FG_PCT", "FG3M", "FG3A", "FG3_PCT", "FTM", "FTA", "FT_PCT", "OREB", "DREB",
+		"REB", "AST", "STL", "BLK", "TOV", "PF", "PTS"}
+)
+
+func resultSet(name string, headers []string, rows [][]interface{}) *endpoints.ResultSet {
+	if rows == nil {
+		// stats.nba.com returns empty row sets, not nulls.
+		rows = [][]interface{}{}
+	}
+	return &endpoints.ResultSet{Name: name, Headers: headers, RowSet: rows}
+}
+
+func franchiseHistory(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var rows [][]interface{}
+	for _, team := range l.teams {
+		startYear, _ := strconv.Atoi(team.StartYear)
+		endYear, _ := strconv.Atoi(team.EndYear)
+		rows = append(rows, []interface{}{leagueID, team.ID, team.City, team.Name, team.StartYear,
+			team.EndYear, endYear - startYear, team.Games, team.Wins, team.Losses,
+			percentage(team.Wins, team.Games), team.PlayOffAppearances, team.DivisionTitles,
+			team.ConferenceTitles, team.LeagueTitles})
+	}
+	return []*endpoints.ResultSet{
+		resultSet("FranchiseHistory", franchiseHistoryHeaders, rows),
+		resultSet("DefunctTeams", franchiseHistoryHeaders, nil),
+	}, nil
+}
+
+func commonAllPlayers(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	onlyCurrent := params.Get("IsOnlyCurrentSeason") == "1"
+	var rows [][]interface{}
+	for _, player := range l.players {
+		if onlyCurrent && player.TeamID == 0 {
+			continue
+		}
+		row := []interface{}{player.ID, player.LastName + ", " + player.FirstName,
+			player.FirstName + " " + player.LastName, int(player.RosterStatus), player.CareerStartYear,
+			player.CareerEndYear, player.PlayerCode, player.TeamID}
+		if team := l.team(player.TeamID); team != nil {
+			row = append(row, team.City, team.Name, abbreviation(team), strings.ToLower(team.Name), "Y")
+		} else {
+			row = append(row, "", "", "", "", "Y")
+		}
+		rows = append(rows, row)
+	}
+	return []*endpoints.ResultSet{
+		resultSet("CommonAllPlayers", commonAllPlayersHeaders, rows),
+	}, nil
+}
+
+func boxScoreSummary(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	id, err := gameIDParam(params)
+	if err != nil {
+		return nil, err
+	}
+	var summary, other, officials, info, lineScore, lastMeeting, series [][]interface{}
+	if game := l.game(id); game != nil {
+		home, visitor := l.team(game.HomeTeamID), l.team(game.VisitorTeamID)
+		summary = [][]interface{}{l.gameSummaryRow(game)}
+		changes, tied := game.leadChanges()
+		for _, team := range []*data.Team{visitor, home} {
+			other = append(other, []interface{}{leagueID, team.ID, abbreviation(team), team.City,
+				0, 0, 0, game.largestLead(team.ID), changes, tied})
+			lineScore = append(lineScore, l.lineScoreRow(game, team))
+		}
+		for _, official := range game.Officials {
+			officials = append(officials, []interface{}{official.ID, official.FirstName,
+				official.LastName, official.JerseyNumber + " "})
+		}
+
+		var gameTime string
+		if game.Status == data.Final {
+			// Games take roughly three times as long as the game clock.
+			minutes := 3 * game.seconds() / 60
+			gameTime = fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+		}
+		info = [][]interface{}{{strings.ToUpper(game.Date.Format("Monday, January 2, 2006")),
+			game.Attendance, gameTime}}
+		lastMeeting = [][]interface{}{l.lastMeetingRow(game)}
+
+		wins, losses := l.seasonSeries(game)
+		leader := "Tied"
+		if wins > losses {
+			leader = home.City
+		} else if losses > wins {
+			leader = visitor.City
+		}
+		series = [][]interface{}{{string(game.ID), home.ID, visitor.ID,
+			game.Date.Format(dateTimeFormat), wins, losses, leader}}
+	}
+	return []*endpoints.ResultSet{
+		resultSet("GameSummary", gameSummaryHeaders, summary),
+		resultSet("OtherStats", otherStatsHeaders, other),
+		resultSet("Officials", officialsHeaders, officials),
+		resultSet("InactivePlayers", inactivePlayersHeaders, nil),
+		resultSet("GameInfo", gameInfoHeaders, info),
+		resultSet("LineScore", lineScoreHeaders, lineScore),
+		resultSet("LastMeeting", lastMeetingHeaders, lastMeeting),
+		resultSet("SeasonSeries", seasonSeriesHeaders, series),
+	}, nil
+}
+
+func boxScoreTraditional(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	id, err := gameIDParam(params)
+	if err != nil {
+		return nil, err
+	}
+	var playerRows, teamRows [][]interface{}
+	if game := l.game(id); game != nil && game.Status != data.Scheduled {
+		visitorPoints, homePoints := game.points()
+		for _, team := range []*data.Team{l.team(game.VisitorTeamID), l.team(game.HomeTeamID)} {
+			var total data.Stats
+			for _, player := range l.roster(team.ID) {
+				stats := game.stats[player.ID]
+				if stats == nil {
+					stats = &data.Stats{}
+				}
+				addStats(&total, stats)
+				playerRows = append(playerRows, append([]interface{}{string(game.ID), team.ID,
+					abbreviation(team), team.City, player.ID, player.FirstName + " " + player.LastName,
+					"", ""}, statCells(nil, stats)...))
+			}
+
+			total.PlusMinus = homePoints - visitorPoints
+			if team.ID == game.VisitorTeamID {
+				total.PlusMinus = -total.PlusMinus
+			}
+			seconds := 5 * game.seconds()
+			minutes := fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+			teamRows = append(teamRows, append([]interface{}{string(game.ID), team.ID, team.Name,
+				abbreviation(team), team.City}, statCells(minutes, &total)...))
+		}
+	}
+	return []*endpoints.ResultSet{
+		resultSet("PlayerStats", playerStatsHeaders, playerRows),
+		resultSet("TeamStats", teamStatsHeaders, teamRows),
+	}, nil
+}
+
+func playByPlay(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	id, err := gameIDParam(params)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]interface{}
+	if game := l.game(id); game != nil {
+		for _, evt := range game.events {
+			var home, neutral, visitor, score, margin interface{}
+			switch {
+			case evt.teamID == game.HomeTeamID:
+				home = evt.description
+			case evt.teamID == game.VisitorTeamID:
+				visitor = evt.description
+			case evt.description != "":
+				neutral = evt.description
+			}
+			if evt.score != nil {
+				// The home team's score comes first, as PlayByPlayRow.Score
+				// reads it.
+				score = fmt.Sprintf("%d - %d", evt.score[1], evt.score[0])
+				if m := evt.score[1] - evt.score[0]; m == 0 {
+					margin = "TIE"
+				} else {
+					margin = strconv.Itoa(m)
+				}
+			}
+
+			row := []interface{}{string(game.ID), evt.number, int(evt.msgType), evt.actionType,
+				evt.period, wallClock(game, evt), evt.clock, home, neutral, visitor, score, margin}
+			row = append(row, l.personCells(game, evt.player)...)
+			row = append(row, l.personCells(game, nil)...)
+			row = append(row, l.personCells(game, nil)...)
+			rows = append(rows, row)
+		}
+	}
+	return []*endpoints.ResultSet{
+		resultSet("PlayByPlay", playByPlayHeaders, rows),
+		resultSet("AvailableVideo", []string{"VIDEO_AVAILABLE_FLAG"}, [][]interface{}{{0}}),
+	}, nil
+}
+
+func scoreboard(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	date, err := time.Parse("01/02/2006", params.Get("gameDate"))
+	if err != nil {
+		return nil, badRequest(fmt.Sprintf("invalid gameDate %q", params.Get("gameDate")))
+	}
+	offset, _ := strconv.Atoi(params.Get("DayOffset"))
+	date = date.AddDate(0, 0, offset)
+
+	var header, lineScore, lastMeeting [][]interface{}
+	for _, game := range l.games {
+		if !sameDay(game.Date, date) {
+			continue
+		}
+		header = append(header, l.gameSummaryRow(game))
+		for _, team := range []*data.Team{l.team(game.VisitorTeamID), l.team(game.HomeTeamID)} {
+			var total data.Stats
+			for _, player := range l.roster(team.ID) {
+				if stats := game.stats[player.ID]; stats != nil {
+					addStats(&total, stats)
+				}
+			}
+			lineScore = append(lineScore, append(l.lineScoreRow(game, team),
+				percentage(total.FieldGoalsMade, total.FieldGoalsAttempted),
+				percentage(total.FreeThrowsMade, total.FreeThrowsAttempted),
+				percentage(total.ThreePointersMade, total.ThreePointersAttempted),
+				total.Assists, total.Rebounds, total.Turnovers))
+		}
+		lastMeeting = append(lastMeeting, l.lastMeetingRow(game))
+	}
+	return []*endpoints.ResultSet{
+		resultSet("GameHeader", gameSummaryHeaders, header),
+		resultSet("LineScore", lineScoreboardHeaders, lineScore),
+		resultSet("SeriesStandings", seasonSeriesHeaders, nil),
+		resultSet("LastMeeting", lastMeetingHeaders, lastMeeting),
+	}, nil
+}
+
+func teamGameLog(l *League, params url.Values) ([]*endpoints.ResultSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	teamID, err := strconv.Atoi(params.Get("TeamID"))
+	if err != nil {
+		return nil, badRequest(fmt.Sprintf("invalid TeamID %q", params.Get("TeamID")))
+	}
+	team := l.team(teamID)
+	playoffs := params.Get("SeasonType") == "Playoffs"
+
+	var rows [][]interface{}
+	var wins, losses int
+	if team != nil && params.Get("Season") == string(l.season) {
+		for _, game := range l.games {
+			if game.Status != data.Final || game.ID.IsPlayoff() != playoffs {
+				continue
+			}
+			if game.HomeTeamID != teamID && game.VisitorTeamID != teamID {
+				continue
+			}
+
+			visitorPoints, homePoints := game.points()
+			points, opponentPoints := homePoints, visitorPoints
+			matchup := fmt.Sprintf("%s vs. %s", abbreviation(team), abbreviation(l.team(game.VisitorTeamID)))
+			if game.VisitorTeamID == teamID {
+				points, opponentPoints = visitorPoints, homePoints
+				matchup = fmt.Sprintf("%s @ %s", abbreviation(team), abbreviation(l.team(game.HomeTeamID)))
+			}
+			result := "L"
+			if points > opponentPoints {
+				result = "W"
+				wins++
+			} else {
+				losses++
+			}
+
+			var total data.Stats
+			for _, player := range l.roster(teamID) {
+				if stats := game.stats[player.ID]; stats != nil {
+					addStats(&total, stats)
+				}
+			}
+			row := []interface{}{teamID, string(game.ID),
+				strings.ToUpper(game.Date.Format("Jan 02, 2006")), matchup, result, wins, losses,
+				percentage(wins, wins+losses), 5 * game.seconds() / 60}
+			row = append(row, statCells(nil, &total)[1:]...)
+			// The game log has no plus-minus, and calls turnovers TOV.
+			rows = append(rows, row[:len(row)-1])
+		}
+	}
+	// Game logs are in reverse chronological order.
+	for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+		rows[i], rows[j] = rows[j], rows[i]
+	}
+	return []*endpoints.ResultSet{
+		resultSet("TeamGameLog", teamGameLogHeaders, rows),
+	}, nil
+}
+
+func gameIDParam(params url.Values) (data.GameID, error) {
+	id := params.Get("GameID")
+	if len(id) != 10 {
+		return "", badRequest(fmt.Sprintf("invalid GameID %q", id))
+	}
+	return data.GameID(id), nil
+}
+
+func (l *League) gameSummaryRow(game *Game) []interface{} {
+	var sequence int
+	for _, g := range l.games {
+		if sameDay(g.Date, game.Date) {
+			sequence++
+		}
+		if g == game {
+			break
+		}
+	}
+
+	var status, clock string
+	switch game.Status {
+	case data.Scheduled:
+		status = game.Date.Format("3:04 pm") + " ET"
+	case data.Live:
+		status = ordinal(game.Period) + " Qtr"
+		if game.Period > 4 {
+			status = fmt.Sprintf("OT%d", game.Period-4)
+		}
+		clock = game.events[len(game.events)-1].clock
+	case data.Final:
+		status = "Final"
+	}
+
+	home, visitor := l.team(game.HomeTeamID), l.team(game.VisitorTeamID)
+	gameCode := game.Date.Format("20060102") + "/" + abbreviation(visitor) + abbreviation(home)
+	return []interface{}{game.Date.Format("2006-01-02") + "T00:00:00", sequence, string(game.ID),
+		int(game.Status), status, gameCode, home.ID, visitor.ID, string(l.season)[:4],
+		game.Period, clock, nil, fmt.Sprintf("Q%d %s", game.Period, clock), 1}
+}
+
+func (l *League) lineScoreRow(game *Game, team *data.Team) []interface{} {
+	row := []interface{}{game.Date.Format("2006-01-02") + "T00:00:00", 1, string(game.ID), team.ID,
+		abbreviation(team), team.City, team.Name, l.record(team.ID, game)}
+	periods := game.periodPoints(team.ID)
+	for period := 0; period < 14; period++ {
+		switch {
+		case period < len(periods):
+			row = append(row, periods[period])
+		case period < 4 && game.Status == data.Scheduled:
+			row = append(row, nil)
+		default:
+			row = append(row, 0)
+		}
+	}
+	return append(row, game.teamPoints[team.ID])
+}
+
+func (l *League) lastMeetingRow(game *Game) []interface{} {
+	last := l.lastMeeting(game)
+	if last == nil {
+		return []interface{}{string(game.ID), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}
+	}
+	home, visitor := l.team(last.HomeTeamID), l.team(last.VisitorTeamID)
+	visitorPoints, homePoints := last.points()
+	return []interface{}{string(game.ID), string(last.ID), last.Date.Format("2006-01-02") + "T00:00:00",
+		home.ID, home.City, home.Name, abbreviation(home), homePoints,
+		visitor.ID, visitor.City, visitor.Name, abbreviation(visitor), visitorPoints}
+}
+
+// record returns a team's wins and losses through the given game, ex. "50-32".
+func (l *League) record(teamID int, through *Game) string {
+	var wins, losses int
+	for _, game := range l.games {
+		if game.Date.After(through.Date) {
+			break
+		}
+		if game.Status != data.Final || game.ID.IsPlayoff() != through.ID.IsPlayoff() {
+			continue
+		}
+		visitorPoints, homePoints := game.points()
+		switch teamID {
+		case game.HomeTeamID:
+			if homePoints > visitorPoints {
+				wins++
+			} else {
+				losses++
+			}
+		case game.VisitorTeamID:
+			if visitorPoints > homePoints {
+				wins++
+			} else {
+				losses++
+			}
+		}
+	}
+	return fmt.Sprintf("%d-%d", wins, losses)
+}
+
+// seasonSeries returns the home team's wins and losses against the visitor
+// through the given game.
+func (l *League) seasonSeries(through *Game) (wins int, losses int) {
+	for _, game := range l.games {
+		if game.Date.After(through.Date) {
+			break
+		}
+		if game.Status != data.Final {
+			continue
+		}
+		visitorPoints, homePoints := game.points()
+		switch {
+		case game.HomeTeamID == through.HomeTeamID && game.VisitorTeamID == through.VisitorTeamID:
+			if homePoints > visitorPoints {
+				wins++
+			} else {
+				losses++
+			}
+		case game.HomeTeamID == through.VisitorTeamID && game.VisitorTeamID == through.HomeTeamID:
+			if visitorPoints > homePoints {
+				wins++
+			} else {
+				losses++
+			}
+		}
+	}
+	return wins, losses
+}
+
+func (l *League) personCells(game *Game, player *Player) []interface{} {
+	if player == nil {
+		return []interface{}{0, 0, nil, nil, nil, nil, nil}
+	}
+	personType := endpoints.VisitorPlayer
+	if player.TeamID == game.HomeTeamID {
+		personType = endpoints.HomePlayer
+	}
+	team := l.team(player.TeamID)
+	return []interface{}{int(personType), player.ID, player.FirstName + " " + player.LastName, team.ID,
+		team.City, team.Name, abbreviation(team)}
+}
+
+func (g *Game) largestLead(teamID int) int {
+	var lead int
+	for _, evt := range g.events {
+		if evt.score == nil {
+			continue
+		}
+		margin := evt.score[1] - evt.score[0]
+		if teamID == g.VisitorTeamID {
+			margin = -margin
+		}
+		if margin > lead {
+			lead = margin
+		}
+	}
+	return lead
+}
+
+// wallClock returns the wall clock time of an event, pretending that games
+// take three times as long as the game clock.
+func wallClock(game *Game, evt *event) string {
+	elapsed := time.Duration(3*playSeconds*evt.number) * time.Second
+	return game.Date.Add(elapsed).Format("3:04 PM")
+}
+
+func statCells(minutes interface{}, stats *data.Stats) []interface{} {
+	return []interface{}{minutes, stats.FieldGoalsMade, stats.FieldGoalsAttempted,
+		percentage(stats.FieldGoalsMade, stats.FieldGoalsAttempted), stats.ThreePointersMade,
+		stats.ThreePointersAttempted, percentage(stats.ThreePointersMade, stats.ThreePointersAttempted),
+		stats.FreeThrowsMade, stats.FreeThrowsAttempted,
+		percentage(stats.FreeThrowsMade, stats.FreeThrowsAttempted), stats.OffensiveRebounds,
+		stats.DefensiveRebounds, stats.Rebounds, stats.Assists, stats.Steals, stats.Blocks,
+		stats.Turnovers, stats.PersonalFouls, stats.Points, stats.PlusMinus}
+}
+
+func addStats(total *data.Stats, stats *data.Stats) {
+	total.FieldGoalsMade += stats.FieldGoalsMade
+	total.FieldGoalsAttempted += stats.FieldGoalsAttempted
+	total.ThreePointersMade += stats.ThreePointersMade
+	total.ThreePointersAttempted += stats.ThreePointersAttempted
+	total.FreeThrowsMade += stats.FreeThrowsMade
+	total.FreeThrowsAttempted += stats.FreeThrowsAttempted
+	total.OffensiveRebounds += stats.OffensiveRebounds
+	total.DefensiveRebounds += stats.DefensiveRebounds
+	total.Rebounds += stats.Rebounds
+	total.Assists += stats.Assists
+	total.Steals += stats.Steals
+	total.Blocks += stats.Blocks
+	total.Turnovers += stats.Turnovers
+	total.PersonalFouls += stats.PersonalFouls
+	total.Points += stats.Points
+}
+
+func percentage(made, attempted int) float64 {
+	if attempted == 0 {
+		return 0
+	}
+	return float64(made) / float64(attempted)
+}
+
+func abbreviation(team *data.Team) string {
+	if team.Abbreviation != nil {
+		return *team.Abbreviation
+	}
+	if len(team.Name) < 3 {
+		return strings.ToUpper(team.Name)
+	}
+	return strings.ToUpper(team.Name[:3])
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,433 @@
+package nbagametest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+)
+
+const (
+	periodSeconds   = 12 * 60
+	overtimeSeconds = 5 * 60
+	// playSeconds is how much of the game clock every play takes.
+	playSeconds = 20
+)
+
+// League is a programmable, in-memory model of the NBA that a Server
+// serves. It is safe for concurrent use, so a test may advance games while
+// a client polls the server.
+type League struct {
+	mu      sync.Mutex
+	season  data.Season
+	teams   []*data.Team
+	players []*Player
+	games   []*Game
+}
+
+// NewLeague constructs an empty League playing the given season.
+func NewLeague(season data.Season) *League {
+	return &League{season: season}
+}
+
+// Player is a player in a League.
+type Player struct {
+	data.Player
+	TeamID int
+}
+
+// Game is a game in a League. Games are scheduled, then started, then
+// played by recording shots, and finally finished.
+type Game struct {
+	ID            data.GameID
+	Date          time.Time
+	HomeTeamID    int
+	VisitorTeamID int
+	Status        data.GameStatus
+	Period        int
+	Attendance    int
+	Officials     []*data.Official
+
+	events []*event
+	stats  map[int]*data.Stats
+	// teamPoints is the number of points scored by each team.
+	teamPoints map[int]int
+	// clock is the number of seconds elapsed in the current period.
+	clock int
+}
+
+// event is a play-by-play event of a Game.
+type event struct {
+	number     int
+	msgType    data.EventType
+	actionType int
+	period     int
+	clock      string
+	player     *Player
+	teamID     int
+	// description is shown from the perspective of the team involved, or
+	// as the neutral description if there isn't one.
+	description string
+	// score is the visitor and home score after the event, for scoring
+	// events.
+	score []int
+}
+
+// AddTeam adds a team to the league.
+func (l *League) AddTeam(team data.Team) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.teams = append(l.teams, &team)
+}
+
+// AddPlayer adds a player to the league, on the roster of the team with the
+// given ID. A teamID of zero adds a retired player.
+func (l *League) AddPlayer(player data.Player, teamID int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.players = append(l.players, &Player{Player: player, TeamID: teamID})
+}
+
+// ScheduleGame schedules a game between two of the league's teams.
+func (l *League) ScheduleGame(id data.GameID, date time.Time, homeTeamID, visitorTeamID int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.team(homeTeamID) == nil {
+		return fmt.Errorf("nbagametest: unknown team %v", homeTeamID)
+	}
+	if l.team(visitorTeamID) == nil {
+		return fmt.Errorf("nbagametest: unknown team %v", visitorTeamID)
+	}
+	if l.game(id) != nil {
+		return fmt.Errorf("nbagametest: game %s is already scheduled", id)
+	}
+	l.games = append(l.games, &Game{
+		ID:            id,
+		Date:          date,
+		HomeTeamID:    homeTeamID,
+		VisitorTeamID: visitorTeamID,
+		Status:        data.Scheduled,
+		stats:         make(map[int]*data.Stats),
+		teamPoints:    make(map[int]int),
+	})
+	sort.SliceStable(l.games, func(i, j int) bool {
+		return l.games[i].Date.Before(l.games[j].Date)
+	})
+	return nil
+}
+
+// StartGame tips off a scheduled game, making it live.
+func (l *League) StartGame(id data.GameID) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	game, err := l.gameWithStatus(id, data.Scheduled)
+	if err != nil {
+		return err
+	}
+	game.Status = data.Live
+	game.startPeriod()
+	return nil
+}
+
+// Score records a made shot by a player in a live game. Shots worth one
+// point are free throws.
+func (l *League) Score(id data.GameID, playerID int, points int) error {
+	return l.shoot(id, playerID, points, true)
+}
+
+// Miss records a missed shot by a player in a live game. Shots worth one
+// point are free throws.
+func (l *League) Miss(id data.GameID, playerID int, points int) error {
+	return l.shoot(id, playerID, points, false)
+}
+
+func (l *League) shoot(id data.GameID, playerID int, points int, made bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	game, err := l.gameWithStatus(id, data.Live)
+	if err != nil {
+		return err
+	}
+	player := l.player(playerID)
+	if player == nil || (player.TeamID != game.HomeTeamID && player.TeamID != game.VisitorTeamID) {
+		return fmt.Errorf("nbagametest: player %v isn't playing in game %s", playerID, id)
+	}
+	if points < 1 || points > 3 {
+		return fmt.Errorf("nbagametest: a shot can't be worth %v points", points)
+	}
+
+	stats := game.playerStats(playerID)
+	evt := &event{player: player, teamID: player.TeamID}
+	switch points {
+	case 1:
+		evt.msgType = data.EventTypeFreeThrow
+		evt.actionType = 10
+		evt.description = player.LastName + " Free Throw 1 of 1"
+		stats.FreeThrowsAttempted++
+	case 2:
+		evt.msgType = data.EventTypeMissedShot
+		evt.actionType = 1
+		evt.description = player.LastName + " Jump Shot"
+		stats.FieldGoalsAttempted++
+	case 3:
+		evt.msgType = data.EventTypeMissedShot
+		evt.actionType = 1
+		evt.description = player.LastName + " 3PT Jump Shot"
+		stats.FieldGoalsAttempted++
+		stats.ThreePointersAttempted++
+	}
+
+	if !made {
+		evt.description = "MISS " + evt.description
+		game.record(evt)
+		return nil
+	}
+
+	switch points {
+	case 1:
+		stats.FreeThrowsMade++
+	case 2:
+		evt.msgType = data.EventTypeMadeShot
+		stats.FieldGoalsMade++
+	case 3:
+		evt.msgType = data.EventTypeMadeShot
+		stats.FieldGoalsMade++
+		stats.ThreePointersMade++
+	}
+	stats.Points += points
+	game.teamPoints[player.TeamID] += points
+	evt.description = fmt.Sprintf("%s (%v PTS)", evt.description, stats.Points)
+	visitor, home := game.points()
+	evt.score = []int{visitor, home}
+	game.record(evt)
+	return nil
+}
+
+// EndPeriod ends the current period of a live game, and starts the next.
+func (l *League) EndPeriod(id data.GameID) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	game, err := l.gameWithStatus(id, data.Live)
+	if err != nil {
+		return err
+	}
+	game.endPeriod()
+	game.startPeriod()
+	return nil
+}
+
+// FinishGame ends a live game. A game can't finish tied, or before the end
+// of the fourth period.
+func (l *League) FinishGame(id data.GameID, attendance int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	game, err := l.gameWithStatus(id, data.Live)
+	if err != nil {
+		return err
+	}
+	if game.Period < 4 {
+		return fmt.Errorf("nbagametest: game %s is only in period %v", id, game.Period)
+	}
+	if visitor, home := game.points(); visitor == home {
+		return fmt.Errorf("nbagametest: game %s is tied", id)
+	}
+	game.endPeriod()
+	game.Status = data.Final
+	game.Attendance = attendance
+	return nil
+}
+
+// Game returns a copy of the game with the given ID, or nil if there isn't
+// one.
+func (l *League) Game(id data.GameID) *Game {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	game := l.game(id)
+	if game == nil {
+		return nil
+	}
+	g := *game
+	return &g
+}
+
+func (l *League) team(id int) *data.Team {
+	for _, team := range l.teams {
+		if team.ID == id {
+			return team
+		}
+	}
+	return nil
+}
+
+func (l *League) player(id int) *Player {
+	for _, player := range l.players {
+		if player.ID == id {
+			return player
+		}
+	}
+	return nil
+}
+
+func (l *League) roster(teamID int) []*Player {
+	var roster []*Player
+	for _, player := range l.players {
+		if player.TeamID == teamID {
+			roster = append(roster, player)
+		}
+	}
+	return roster
+}
+
+func (l *League) game(id data.GameID) *Game {
+	for _, game := range l.games {
+		if game.ID == id {
+			return game
+		}
+	}
+	return nil
+}
+
+func (l *League) gameWithStatus(id data.GameID, status data.GameStatus) (*Game, error) {
+	game := l.game(id)
+	if game == nil {
+		return nil, fmt.Errorf("nbagametest: unknown game %s", id)
+	}
+	if game.Status != status {
+		return nil, fmt.Errorf("nbagametest: game %s is %s, not %s", id, game.Status, status)
+	}
+	return game, nil
+}
+
+// lastMeeting returns the last finished game between the game's teams
+// before it, or nil if there isn't one.
+func (l *League) lastMeeting(game *Game) *Game {
+	var last *Game
+	for _, g := range l.games {
+		if !g.Date.Before(game.Date) || g.Status != data.Final {
+			continue
+		}
+		if (g.HomeTeamID == game.HomeTeamID && g.VisitorTeamID == game.VisitorTeamID) ||
+			(g.HomeTeamID == game.VisitorTeamID && g.VisitorTeamID == game.HomeTeamID) {
+			last = g
+		}
+	}
+	return last
+}
+
+func (g *Game) record(evt *event) {
+	evt.number = len(g.events) + 1
+	evt.period = g.Period
+	if evt.msgType != data.EventTypePeriodStart && evt.msgType != data.EventTypePeriodEnd {
+		g.clock += playSeconds
+		if g.clock > g.periodLength() {
+			g.clock = g.periodLength()
+		}
+	}
+	remaining := g.periodLength() - g.clock
+	evt.clock = fmt.Sprintf("%d:%02d", remaining/60, remaining%60)
+	g.events = append(g.events, evt)
+}
+
+func (g *Game) startPeriod() {
+	g.Period++
+	g.clock = 0
+	g.record(&event{msgType: data.EventTypePeriodStart})
+}
+
+func (g *Game) endPeriod() {
+	g.clock = g.periodLength()
+	g.record(&event{
+		msgType:     data.EventTypePeriodEnd,
+		description: fmt.Sprintf("End of %s Period", ordinal(g.Period)),
+	})
+}
+
+func (g *Game) periodLength() int {
+	if g.Period > 4 {
+		return overtimeSeconds
+	}
+	return periodSeconds
+}
+
+// seconds returns the number of seconds played so far.
+func (g *Game) seconds() int {
+	if g.Period == 0 {
+		return 0
+	}
+	if g.Period <= 4 {
+		return (g.Period-1)*periodSeconds + g.clock
+	}
+	return 4*periodSeconds + (g.Period-5)*overtimeSeconds + g.clock
+}
+
+func (g *Game) playerStats(playerID int) *data.Stats {
+	stats, ok := g.stats[playerID]
+	if !ok {
+		stats = &data.Stats{}
+		g.stats[playerID] = stats
+	}
+	return stats
+}
+
+// points returns the visitor and home points scored so far.
+func (g *Game) points() (visitor int, home int) {
+	return g.teamPoints[g.VisitorTeamID], g.teamPoints[g.HomeTeamID]
+}
+
+// periodPoints returns the points scored by a team in each period so far.
+func (g *Game) periodPoints(teamID int) []int {
+	points := make([]int, g.Period)
+	for _, evt := range g.events {
+		if evt.score != nil && evt.teamID == teamID {
+			points[evt.period-1] += shotPoints(evt)
+		}
+	}
+	return points
+}
+
+// leadChanges returns the number of times the lead changed hands, and the
+// number of times the score was tied.
+func (g *Game) leadChanges() (changes int, tied int) {
+	var leader int
+	for _, evt := range g.events {
+		if evt.score == nil {
+			continue
+		}
+		margin := evt.score[1] - evt.score[0]
+		if margin == 0 {
+			tied++
+			continue
+		}
+		if leader != 0 && (margin > 0) != (leader > 0) {
+			changes++
+		}
+		leader = margin
+	}
+	return changes, tied
+}
+
+func shotPoints(evt *event) int {
+	switch {
+	case evt.msgType == data.EventTypeFreeThrow:
+		return 1
+	case strings.Contains(evt.description, "3PT"):
+		return 3
+	default:
+		return 2
+	}
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	}
+	return fmt.Sprintf("%dth", n)
+}
@@ -0,0 +1,114 @@
+// Package nbagametest provides a fake stats.nba.com server for testing code
+// that uses the nbagame Client, without depending on the real API.
+//
+// A Server serves a League, an in-memory model of teams, players and games
+// that a test programs as it goes:
+//
+//	league := nbagametest.NewLeague("2014-15")
+//	league.AddTeam(data.Team{ID: 1610612737, City: "Atlanta", Name: "Hawks"})
+//	...
+//	server := nbagametest.NewServer(league)
+//	defer server.Close()
+//
+//	client := server.Client()
+//	league.StartGame("0021401185")
+//	league.Score("0021401185", 201143, 2)
+//	details, err := client.GameDetails(ctx, "0021401185")
+//
+// The Server speaks the stats.nba.com 'resultSets' protocol for the
+// franchisehistory, commonallplayers, boxscoresummaryv2,
+// boxscoretraditionalv2, playbyplayv2, scoreboardV2 and teamgamelog
+// endpoints.
+package nbagametest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/jbowens/nbagame"
+	"github.com/jbowens/nbagame/endpoints"
+)
+
+// Server is a fake stats.nba.com server, serving a League.
+type Server struct {
+	*httptest.Server
+	League *League
+
+	handlers map[string]handler
+}
+
+// handler builds the result sets for a request to an endpoint.
+type handler func(l *League, params url.Values) ([]*endpoints.ResultSet, error)
+
+// badRequest is returned by a handler when the request's parameters are
+// invalid.
+type badRequest string
+
+func (err badRequest) Error() string {
+	return string(err)
+}
+
+// NewServer starts a Server serving the given League. The caller should call
+// Close when finished, to shut it down.
+func NewServer(league *League) *Server {
+	s := &Server{
+		League: league,
+		handlers: map[string]handler{
+			"franchisehistory":      franchiseHistory,
+			"commonallplayers":      commonAllPlayers,
+			"boxscoresummaryv2":     boxScoreSummary,
+			"boxscoretraditionalv2": boxScoreTraditional,
+			"playbyplayv2":          playByPlay,
+			"scoreboardV2":          scoreboard,
+			"teamgamelog":           teamGameLog,
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a Client that retrieves data from the server. It doesn't
+// retry or rate limit requests, unless configured to with opts.
+func (s *Server) Client(opts ...nbagame.ClientOption) *nbagame.Client {
+	opts = append([]nbagame.ClientOption{
+		nbagame.WithHTTPClient(s.Server.Client()),
+		nbagame.WithDomain(strings.TrimPrefix(s.URL, "http://")),
+		nbagame.WithPathPrefix(endpoints.NBAStatsPathPrefix),
+		nbagame.WithRetryPolicy(nil),
+		nbagame.WithRateLimiter(nil),
+	}, opts...)
+	return nbagame.NewClient(opts...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := strings.TrimPrefix(r.URL.Path, "/"+endpoints.NBAStatsPathPrefix+"/")
+	handle, ok := s.handlers[endpoint]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	params := r.URL.Query()
+	resultSets, err := handle(s.League, params)
+	if _, ok := err.(badRequest); ok {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	parameters := make(map[string]string, len(params))
+	for name := range params {
+		parameters[name] = params.Get(name)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&endpoints.Response{
+		Resource:   endpoint,
+		Parameters: parameters,
+		ResultSets: resultSets,
+	})
+}
@@ -0,0 +1,187 @@
+package nbagametest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbowens/nbagame/data"
+	"github.com/jbowens/nbagame/endpoints"
+)
+
+const (
+	hawksID = 1610612737
+	bullsID = 1610612741
+	gameID  = data.GameID("0021401185")
+	horford = 201143
+	korver  = 2594
+	rose    = 201565
+)
+
+func testLeague(t *testing.T) (*League, time.Time) {
+	league := NewLeague("2014-15")
+	league.AddTeam(data.Team{ID: hawksID, City: "Atlanta", Name: "Hawks", StartYear: "1949", EndYear: "2015"})
+	league.AddTeam(data.Team{ID: bullsID, City: "Chicago", Name: "Bulls", StartYear: "1966", EndYear: "2015"})
+	league.AddPlayer(data.Player{ID: horford, FirstName: "Al", LastName: "Horford", RosterStatus: data.Active}, hawksID)
+	league.AddPlayer(data.Player{ID: korver, FirstName: "Kyle", LastName: "Korver", RosterStatus: data.Active}, hawksID)
+	league.AddPlayer(data.Player{ID: rose, FirstName: "Derrick", LastName: "Rose", RosterStatus: data.Active}, bullsID)
+	league.AddPlayer(data.Player{ID: 76375, FirstName: "Dominique", LastName: "Wilkins"}, 0)
+
+	date := time.Date(2015, time.April, 13, 19, 30, 0, 0, time.UTC)
+	if err := league.ScheduleGame(gameID, date, hawksID, bullsID); err != nil {
+		t.Fatal(err)
+	}
+	return league, date
+}
+
+func TestServerTeamsAndPlayers(t *testing.T) {
+	league, _ := testLeague(t)
+	server := NewServer(league)
+	defer server.Close()
+	client := server.Client()
+
+	teams, err := client.Teams(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 2 || teams[0].ID != hawksID || teams[1].Name != "Bulls" {
+		t.Errorf("unexpected teams: %v", teams)
+	}
+
+	players, err := client.Players(context.Background(), "2014-15")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(players) != 3 {
+		t.Errorf("expected 3 current players, got %v", players)
+	}
+	players, err = client.HistoricalPlayers(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(players) != 4 || players[3].FirstName != "Dominique" || players[3].LastName != "Wilkins" {
+		t.Errorf("expected 4 players, including retired ones, got %v", players)
+	}
+}
+
+func TestServerLiveGame(t *testing.T) {
+	ctx := context.Background()
+	league, date := testLeague(t)
+	server := NewServer(league)
+	defer server.Close()
+	client := server.Client()
+
+	games, err := client.GamesByDate(ctx, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 1 || games[0].ID != gameID || games[0].Status != data.Scheduled {
+		t.Fatalf("expected a scheduled game, got %+v", games)
+	}
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	must(league.StartGame(gameID))
+	must(league.Score(gameID, rose, 2))
+	must(league.Score(gameID, korver, 3))
+	must(league.Miss(gameID, horford, 2))
+	must(league.Score(gameID, horford, 1))
+
+	details, err := client.GameDetails(ctx, string(gameID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Status != data.Live || details.HomePoints.FirstQuarter != 4 || details.VisitorPoints.FirstQuarter != 2 {
+		t.Errorf("expected a live game, 4-2 in the first quarter, got %+v", details)
+	}
+	if details.LeadChanges != 1 {
+		t.Errorf("expected 1 lead change, got %v", details.LeadChanges)
+	}
+
+	for period := 1; period < 4; period++ {
+		must(league.EndPeriod(gameID))
+	}
+	must(league.Score(gameID, rose, 2))
+	if err := league.FinishGame(gameID, 18047); err == nil {
+		t.Error("expected an error finishing a tied game")
+	}
+	must(league.Score(gameID, horford, 2))
+	must(league.FinishGame(gameID, 18047))
+
+	details, err = client.GameDetails(ctx, string(gameID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Status != data.Final || details.Attendance != 18047 || details.HomePoints.FourthQuarter != 2 {
+		t.Errorf("expected a final game, got %+v", details)
+	}
+	if details.TimesTied != 1 {
+		t.Errorf("expected the game to be tied once, got %v", details.TimesTied)
+	}
+
+	boxScore, err := client.BoxScore(ctx, "2014-15", string(gameID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boxScore.TeamStats) != 2 || len(boxScore.PlayerStats) != 3 {
+		t.Fatalf("expected stats for 2 teams and 3 players, got %+v", boxScore)
+	}
+	for _, ts := range boxScore.TeamStats {
+		if ts.TeamID == hawksID && (ts.Points != 6 || ts.PlusMinus != 2 || ts.FieldGoalsAttempted != 3) {
+			t.Errorf("unexpected Hawks stats: %+v", ts.Stats)
+		}
+		if ts.SecondsPlayed != 240*60 {
+			t.Errorf("expected a 48 minute game, got %v seconds", ts.SecondsPlayed)
+		}
+	}
+
+	events, err := client.GamePlayByPlay(ctx, "2014-15", string(gameID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shots int
+	var scores []*data.Score
+	for _, event := range events {
+		if event.Shot != nil {
+			shots++
+		}
+		if event.Score != nil {
+			scores = append(scores, event.Score)
+		}
+	}
+	if shots != 5 {
+		t.Errorf("expected 5 shots, got %v", shots)
+	}
+	if len(scores) != 5 || scores[0].Home != 0 || scores[0].Visitor != 2 || scores[4].Home != 6 || scores[4].Visitor != 4 {
+		t.Errorf("expected the Bulls to score first and the Hawks to win 6-4, got %+v", scores)
+	}
+
+	gameIDs, err := client.GamesPlayedBy(ctx, "2014-15", bullsID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gameIDs) != 1 || gameIDs[0] != gameID {
+		t.Errorf("expected the Bulls to have played %s, got %v", gameID, gameIDs)
+	}
+}
+
+func TestServerRejectsBadRequests(t *testing.T) {
+	league, _ := testLeague(t)
+	server := NewServer(league)
+	defer server.Close()
+
+	if _, err := server.Client().GameDetails(context.Background(), "12"); err == nil {
+		t.Error("expected an error for an invalid game ID")
+	}
+	if _, err := server.Client().GameDetails(context.Background(), "0021401186"); !errors.Is(err, endpoints.ErrNotFound) {
+		t.Errorf("expected an unknown game to be not found, got %v", err)
+	}
+	if err := league.Score(gameID, rose, 2); err == nil {
+		t.Error("expected an error scoring in a game that hasn't started")
+	}
+}
@@ -0,0 +1,156 @@
+package nbagame
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jbowens/nbagame/endpoints"
+)
+
+// ClientOption configures a Client constructed by NewClient.
+type ClientOption func(*clientConfig)
+
+// clientConfig holds the configuration built up by ClientOptions.
+type clientConfig struct {
+	*endpoints.Requester
+	// transport, if set, replaces the HTTP client's transport once every
+	// option has run.
+	transport http.RoundTripper
+}
+
+// NewClient constructs a new Client. Without any options, the Client
+// behaves like DefaultClient. It doesn't share any configuration with other
+// clients, except for the endpoints.DefaultRateLimiter which all clients
+// share unless configured otherwise.
+func NewClient(opts ...ClientOption) *Client {
+	retry := endpoints.DefaultRetryPolicy
+	requester := &endpoints.Requester{
+		Domain:     endpoints.NBAStatsDomain,
+		PathPrefix: endpoints.NBAStatsPathPrefix,
+		Retry:      &retry,
+		Limiter:    endpoints.DefaultRateLimiter,
+	}
+	config := &clientConfig{Requester: requester}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.transport != nil {
+		var client http.Client
+		if requester.HTTPClient != nil {
+			client = *requester.HTTPClient
+		}
+		client.Transport = config.transport
+		requester.HTTPClient = &client
+	}
+	return &Client{requester: requester}
+}
+
+// WithHTTPClient returns a ClientOption that performs requests using the
+// provided http.Client.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *clientConfig) {
+		c.HTTPClient = client
+	}
+}
+
+// WithTransport returns a ClientOption that performs requests through the
+// provided http.RoundTripper. It may be combined with WithHTTPClient, in
+// either order, in which case the transport replaces that client's
+// transport without modifying the client itself.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *clientConfig) {
+		c.transport = transport
+	}
+}
+
+// WithDomain returns a ClientOption that sends requests to the given domain,
+// which may include a port, instead of stats.nba.com.
+func WithDomain(domain string) ClientOption {
+	return func(c *clientConfig) {
+		c.Domain = domain
+	}
+}
+
+// WithPathPrefix returns a ClientOption that sets the path prefix placed
+// before each endpoint's name. The default is "stats".
+func WithPathPrefix(prefix string) ClientOption {
+	return func(c *clientConfig) {
+		c.PathPrefix = prefix
+	}
+}
+
+// WithScheme returns a ClientOption that sets the URL scheme, ex. "https".
+func WithScheme(scheme string) ClientOption {
+	return func(c *clientConfig) {
+		c.Scheme = scheme
+	}
+}
+
+// WithHeader returns a ClientOption that adds a header sent with every
+// request. Headers set this way take precedence over the defaults.
+func WithHeader(key, value string) ClientOption {
+	return func(c *clientConfig) {
+		if c.Header == nil {
+			c.Header = make(http.Header)
+		}
+		c.Header.Add(key, value)
+	}
+}
+
+// WithTimeout returns a ClientOption that bounds the duration of every
+// individual request made by the Client.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.Timeout = timeout
+	}
+}
+
+// WithRetryPolicy returns a ClientOption that retries failed requests
+// according to the given policy. A nil policy disables retries.
+func WithRetryPolicy(policy *endpoints.RetryPolicy) ClientOption {
+	return func(c *clientConfig) {
+		c.Retry = policy
+	}
+}
+
+// WithRateLimiter returns a ClientOption that limits the Client's requests
+// with the given RateLimiter, which may be shared with other clients. A nil
+// limiter disables rate limiting.
+func WithRateLimiter(limiter *endpoints.RateLimiter) ClientOption {
+	return func(c *clientConfig) {
+		c.Limiter = limiter
+	}
+}
+
+// WithCache returns a ClientOption that caches responses in the given
+// Cache, such as an endpoints.MemoryCache or endpoints.DiskCache.
+func WithCache(cache endpoints.Cache) ClientOption {
+	return func(c *clientConfig) {
+		c.Cache = cache
+	}
+}
+
+// WithRecorder returns a ClientOption that records responses to, or replays
+// them from, the Recorder's fixtures directory.
+func WithRecorder(recorder *endpoints.Recorder) ClientOption {
+	return func(c *clientConfig) {
+		c.Recorder = recorder
+	}
+}
+
+// WithMiddleware returns a ClientOption that wraps every request in the
+// given middleware, after any middleware added before.
+func WithMiddleware(middleware ...endpoints.Middleware) ClientOption {
+	return func(c *clientConfig) {
+		c.Middleware = append(c.Middleware, middleware...)
+	}
+}
+
+// WithDecodeMode returns a ClientOption that decodes responses in the given
+// mode, ex. endpoints.DecodeLenient to tolerate missing columns. Use
+// middleware to observe the SchemaDrift recorded on each Call.
+func WithDecodeMode(mode endpoints.DecodeMode) ClientOption {
+	return func(c *clientConfig) {
+		c.DecodeMode = mode
+	}
+}
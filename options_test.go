@@ -0,0 +1,66 @@
+package nbagame
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const franchiseHistoryBody = `{
+	"resource": "franchisehistory",
+	"parameters": {"LeagueID": "00"},
+	"resultSets": [{
+		"name": "FranchiseHistory",
+		"headers": ["LEAGUE_ID", "TEAM_ID", "TEAM_CITY", "TEAM_NAME", "START_YEAR", "END_YEAR",
+			"YEARS", "GAMES", "WINS", "LOSSES", "WIN_PCT", "PO_APPEARANCES", "DIV_TITLES",
+			"CONF_TITLES", "LEAGUE_TITLES"],
+		"rowSet": [["00", 1610612737, "Atlanta", "Hawks", "1949", "2015", 67, 5312, 2634, 2678,
+			0.496, 44, 11, 0, 1]]
+	}]
+}`
+
+func TestNewClientOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/franchisehistory" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+		}
+		if got := r.Header.Get("X-Team"); got != "hawks" {
+			t.Errorf("expected X-Team header to be hawks, got %q", got)
+		}
+		w.Write([]byte(franchiseHistoryBody))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithDomain(strings.TrimPrefix(server.URL, "http://")),
+		WithPathPrefix("api"),
+		WithHeader("X-Team", "hawks"),
+		WithTransport(http.DefaultTransport),
+	)
+	teams, err := client.Teams(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 1 || teams[0].Name != "Hawks" {
+		t.Errorf("expected the Hawks, got %+v", teams)
+	}
+}
+
+func TestWithTransportInEitherOrder(t *testing.T) {
+	transport := &http.Transport{}
+	httpClient := &http.Client{}
+	for _, opts := range [][]ClientOption{
+		{WithTransport(transport), WithHTTPClient(httpClient)},
+		{WithHTTPClient(httpClient), WithTransport(transport)},
+	} {
+		client := NewClient(opts...)
+		if client.requester.HTTPClient.Transport != transport {
+			t.Errorf("expected the transport to be used, got %v", client.requester.HTTPClient.Transport)
+		}
+	}
+	if httpClient.Transport != nil {
+		t.Error("expected the provided http.Client to be left unmodified")
+	}
+}